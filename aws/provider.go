@@ -40,7 +40,7 @@ type aws struct {
 
 // NewProvider returns an AWS Provider
 func NewProvider(ctx context.Context, accessKey, secretKey, region, sessionToken string) (provider.Provider, error) {
-	log.Get().Log("func", "reader.New", "msg", "configuring aws Reader")
+	log.FromContext(ctx).Log("func", "reader.New", "msg", "configuring aws Reader")
 	awsr, err := reader.New(ctx, accessKey, secretKey, region, sessionToken, nil)
 	if err != nil {
 		return nil, fmt.Errorf("could not initialize 'reader' because: %s", err)
@@ -53,7 +53,7 @@ func NewProvider(ctx context.Context, accessKey, secretKey, region, sessionToken
 		Token:     sessionToken,
 	}
 
-	log.Get().Log("func", "aws.NewProvider", "msg", "configuring TF Client")
+	log.FromContext(ctx).Log("func", "aws.NewProvider", "msg", "configuring TF Client")
 	awsClient, err := cfg.Client()
 	if err != nil {
 		return nil, fmt.Errorf("could not initialize 'terraform/aws.Config.Client()' because: %s", err)
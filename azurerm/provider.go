@@ -27,13 +27,13 @@ type azurerm struct {
 
 // NewProvider returns a AzureRM Provider
 func NewProvider(ctx context.Context, clientID, clientSecret, environment, resourceGroupName, subscriptionID, tenantID string) (provider.Provider, error) {
-	log.Get().Log("func", "azurerm.NewProvider", "msg", "loading Azure reader")
+	log.FromContext(ctx).Log("func", "azurerm.NewProvider", "msg", "loading Azure reader")
 	reader, err := NewAzureReader(ctx, clientID, clientSecret, environment, resourceGroupName, subscriptionID, tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("could not initialize AzureReader: %s", err)
 	}
 
-	log.Get().Log("func", "azurerm.NewProvider", "msg", "loading TF provider")
+	log.FromContext(ctx).Log("func", "azurerm.NewProvider", "msg", "loading TF provider")
 	tfp := tfazurerm.Provider()
 
 	rawCfg := terraform.NewResourceConfigRaw(map[string]interface{}{
@@ -44,7 +44,7 @@ func NewProvider(ctx context.Context, clientID, clientSecret, environment, resou
 		"tenant_id":       tenantID,
 	})
 
-	log.Get().Log("func", "azurerm.NewProvider", "msg", "loading TF client")
+	log.FromContext(ctx).Log("func", "azurerm.NewProvider", "msg", "loading TF client")
 	if diags := tfp.Configure(ctx, rawCfg); diags.HasError() {
 		return nil, fmt.Errorf("could not initialize 'terraform/azurerm.Provider.Configure()' because: %s", diags[0].Summary)
 	}
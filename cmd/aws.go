@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -11,6 +10,7 @@ import (
 	"github.com/cycloidio/terracognita/aws"
 	"github.com/cycloidio/terracognita/filter"
 	"github.com/cycloidio/terracognita/hcl"
+	"github.com/cycloidio/terracognita/imports"
 	"github.com/cycloidio/terracognita/log"
 	"github.com/cycloidio/terracognita/provider"
 	"github.com/cycloidio/terracognita/state"
@@ -73,7 +73,8 @@ var (
 				tags = append(tags, tg)
 			}
 
-			ctx := context.Background()
+			ctx, cancel := importContext()
+			defer cancel()
 
 			awsP, err := aws.NewProvider(ctx, viper.GetString("access-key"), viper.GetString("secret-key"), viper.GetString("region"), viper.GetString("session-token"))
 			if err != nil {
@@ -88,7 +89,7 @@ var (
 			}
 
 			var hclW, stateW writer.Writer
-			options, err := getWriterOptions()
+			options, err := getWriterOptions(fmt.Sprintf("the AWS region %s", viper.GetString("region")))
 			if err != nil {
 				return err
 			}
@@ -103,12 +104,20 @@ var (
 				stateW = state.NewWriter(stateOut, options)
 			}
 
+			if importsOut != nil {
+				logger.Log("msg", "initializing Import blocks writer")
+				stateW = writer.NewMulti(stateW, imports.NewWriter(importsOut, options))
+			}
+
 			logger.Log("msg", "importing")
 
 			fmt.Fprintf(logsOut, "Starting Terracognita with version %s\n", Version)
 			logger.Log("msg", "starting terracognita", "version", Version)
 			err = provider.Import(ctx, awsP, hclW, stateW, f, logsOut)
 			if err != nil {
+				if perr := postRunEDeadline(cmd, args, err); perr != nil {
+					return perr
+				}
 				return fmt.Errorf("could not import from AWS: %+v", err)
 			}
 
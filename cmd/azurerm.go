@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	kitlog "github.com/go-kit/kit/log"
@@ -12,6 +11,7 @@ import (
 	"github.com/cycloidio/terracognita/azurerm"
 	"github.com/cycloidio/terracognita/filter"
 	"github.com/cycloidio/terracognita/hcl"
+	"github.com/cycloidio/terracognita/imports"
 	"github.com/cycloidio/terracognita/log"
 	"github.com/cycloidio/terracognita/provider"
 	"github.com/cycloidio/terracognita/state"
@@ -48,7 +48,8 @@ var (
 				return err
 			}
 
-			ctx := context.Background()
+			ctx, cancel := importContext()
+			defer cancel()
 
 			azureRMP, err := azurerm.NewProvider(
 				ctx,
@@ -70,7 +71,7 @@ var (
 			}
 
 			var hclW, stateW writer.Writer
-			options, err := getWriterOptions()
+			options, err := getWriterOptions(fmt.Sprintf("the Azure resource group %s", viper.GetString("resource-group-name")))
 			if err != nil {
 				return err
 			}
@@ -85,12 +86,20 @@ var (
 				stateW = state.NewWriter(stateOut, options)
 			}
 
+			if importsOut != nil {
+				logger.Log("msg", "initializing Import blocks writer")
+				stateW = writer.NewMulti(stateW, imports.NewWriter(importsOut, options))
+			}
+
 			logger.Log("msg", "importing")
 
 			fmt.Fprintf(logsOut, "Starting Terracognita with version %s\n", Version)
 			logger.Log("msg", "starting terracognita", "version", Version)
 			err = provider.Import(ctx, azureRMP, hclW, stateW, f, logsOut)
 			if err != nil {
+				if perr := postRunEDeadline(cmd, args, err); perr != nil {
+					return perr
+				}
 				return errors.Wrap(err, "could not import from Azure")
 			}
 
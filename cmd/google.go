@@ -1,8 +1,12 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
 
 	kitlog "github.com/go-kit/kit/log"
 	"github.com/pkg/errors"
@@ -12,6 +16,7 @@ import (
 	"github.com/cycloidio/terracognita/filter"
 	"github.com/cycloidio/terracognita/google"
 	"github.com/cycloidio/terracognita/hcl"
+	"github.com/cycloidio/terracognita/imports"
 	"github.com/cycloidio/terracognita/log"
 	"github.com/cycloidio/terracognita/provider"
 	"github.com/cycloidio/terracognita/state"
@@ -19,21 +24,70 @@ import (
 	"github.com/cycloidio/terracognita/writer"
 )
 
+// googleCredentialsEnv is the env with the content
+// of the JSON credentials to use if the --credentials
+// flag is not set
+const googleCredentialsEnv = "GOOGLE_CREDENTIALS_JSON"
+
 var (
+	googleEndpoints      map[string]string
+	googleEmulators      map[string]string
+	googleAPIConcurrency map[string]int
+	googleRawFilters     []string
+
+	// googleExistingState is the content of the --existing-state
+	// which is read before the outputs are opened as it could
+	// be the same file as the --tfstate
+	googleExistingState []byte
+
 	googleCmd = &cobra.Command{
 		Use:   "google",
 		Short: "Terracognita reads from GCP and generates hcl resources and/or terraform state",
 		Long:  "Terracognita reads from GCP and generates hcl resources and/or terraform state",
 		PreRunE: func(cmd *cobra.Command, args []string) error {
+			viper.BindPFlag("existing-state", cmd.Flags().Lookup("existing-state"))
+			if es := viper.GetString("existing-state"); es != "" {
+				b, err := ioutil.ReadFile(es)
+				if err != nil {
+					return fmt.Errorf("could not read %s because: %s", es, err)
+				}
+				googleExistingState = b
+			}
+
 			err := preRunEOutput(cmd, args)
 			if err != nil {
 				return err
 			}
 			viper.BindPFlag("credentials", cmd.Flags().Lookup("credentials"))
 			viper.BindPFlag("project", cmd.Flags().Lookup("project"))
+			viper.BindPFlag("all-projects", cmd.Flags().Lookup("all-projects"))
+			viper.BindPFlag("projects-include", cmd.Flags().Lookup("projects-include"))
+			viper.BindPFlag("projects-exclude", cmd.Flags().Lookup("projects-exclude"))
 			viper.BindPFlag("region", cmd.Flags().Lookup("region"))
 			viper.BindPFlag("labels", cmd.Flags().Lookup("labels"))
+			viper.BindPFlag("mandatory-labels", cmd.Flags().Lookup("mandatory-labels"))
+			viper.BindPFlag("network", cmd.Flags().Lookup("network"))
+			viper.BindPFlag("name-prefix", cmd.Flags().Lookup("name-prefix"))
+			viper.BindPFlag("status", cmd.Flags().Lookup("status"))
 			viper.BindPFlag("max-results", cmd.Flags().Lookup("max-results"))
+			viper.BindPFlag("max-concurrency", cmd.Flags().Lookup("max-concurrency"))
+			viper.BindPFlag("max-resources-per-type", cmd.Flags().Lookup("max-resources-per-type"))
+			viper.BindPFlag("resources-threshold", cmd.Flags().Lookup("resources-threshold"))
+			viper.BindPFlag("resources-threshold-warn", cmd.Flags().Lookup("resources-threshold-warn"))
+			viper.BindPFlag("strict-apis", cmd.Flags().Lookup("strict-apis"))
+			viper.BindPFlag("preflight", cmd.Flags().Lookup("preflight"))
+			viper.BindPFlag("experimental", cmd.Flags().Lookup("experimental"))
+			viper.BindPFlag("organization", cmd.Flags().Lookup("organization"))
+			viper.BindPFlag("folder", cmd.Flags().Lookup("folder"))
+			viper.BindPFlag("customer", cmd.Flags().Lookup("customer"))
+			viper.BindPFlag("access-policy", cmd.Flags().Lookup("access-policy"))
+			viper.BindPFlag("provider-version", cmd.Flags().Lookup("provider-version"))
+			viper.BindPFlag("billing-account", cmd.Flags().Lookup("billing-account"))
+			viper.BindPFlag("user-agent", cmd.Flags().Lookup("user-agent"))
+			viper.BindPFlag("log-requests", cmd.Flags().Lookup("log-requests"))
+			viper.BindPFlag("proxy", cmd.Flags().Lookup("proxy"))
+			viper.BindPFlag("ca-file", cmd.Flags().Lookup("ca-file"))
+			viper.BindPFlag("zones", cmd.Flags().Lookup("zones"))
 
 			return nil
 		},
@@ -42,7 +96,15 @@ var (
 			logger := log.Get()
 			logger = kitlog.With(logger, "func", "cmd.google.RunE")
 			// Validate required flags
-			if err := requiredStringFlags("region", "project", "credentials"); err != nil {
+			allProjects := viper.GetBool("all-projects")
+			if allProjects {
+				if err := requiredStringFlags("region"); err != nil {
+					return err
+				}
+				if viper.GetString("project") != "" {
+					return errors.New("the flag \"project\" can not be used with \"all-projects\"")
+				}
+			} else if err := requiredStringFlags("region", "project"); err != nil {
 				return err
 			}
 
@@ -56,28 +118,125 @@ var (
 				tags = append(tags, tg)
 			}
 
-			ctx := context.Background()
+			mandatoryLabels, err := googleMandatoryLabels()
+			if err != nil {
+				return err
+			}
+
+			// Initialize the raw filters
+			rawFilters := make(map[string]string, len(googleRawFilters))
+			for _, rf := range googleRawFilters {
+				kv := strings.SplitN(rf, ":", 2)
+				if len(kv) != 2 {
+					return fmt.Errorf("invalid format for --raw-filter with value %q", rf)
+				}
+				rawFilters[kv[0]] = kv[1]
+			}
+
+			opts := google.Options{
+				Endpoints:              googleEndpoints,
+				Emulators:              googleEmulators,
+				MaxConcurrency:         viper.GetInt("max-concurrency"),
+				APIConcurrency:         googleAPIConcurrency,
+				MaxResourcesPerType:    viper.GetInt("max-resources-per-type"),
+				ResourcesThreshold:     viper.GetInt("resources-threshold"),
+				ResourcesThresholdWarn: viper.GetBool("resources-threshold-warn"),
+				RawFilters:             rawFilters,
+				MandatoryLabels:        mandatoryLabels,
+				StrictAPIs:             viper.GetBool("strict-apis"),
+				Experimental:           viper.GetBool("experimental"),
+				Organization:           viper.GetString("organization"),
+				Folder:                 viper.GetString("folder"),
+				Customer:               viper.GetString("customer"),
+				AccessPolicy:           viper.GetString("access-policy"),
+				ProviderVersion:        viper.GetInt("provider-version"),
+				ProjectsInclude:        viper.GetStringSlice("projects-include"),
+				ProjectsExclude:        viper.GetStringSlice("projects-exclude"),
+				BillingAccount:         viper.GetString("billing-account"),
+				UserAgent:              viper.GetString("user-agent"),
+				LogRequests:            viper.GetBool("log-requests"),
+				Zones:                  viper.GetStringSlice("zones"),
+				Timings:                viper.GetBool("verbose"),
+				Proxy:                  viper.GetString("proxy"),
+				CAFile:                 viper.GetString("ca-file"),
+			}
+			if viper.GetString("credentials") == "" {
+				opts.CredentialsJSON = os.Getenv(googleCredentialsEnv)
+			}
+			if googleExistingState != nil {
+				opts.ExistingState = bytes.NewReader(googleExistingState)
+			}
+
+			ctx, cancel := importContext()
+			defer cancel()
 
-			googleP, err := google.NewProvider(
-				ctx,
-				viper.GetUint64("max-results"),
-				viper.GetString("project"),
-				viper.GetString("region"),
-				viper.GetString("credentials"),
-			)
+			var googleP provider.Provider
+			if allProjects {
+				googleP, err = google.NewDiscoveryProvider(
+					ctx,
+					viper.GetUint64("max-results"),
+					viper.GetString("region"),
+					viper.GetString("credentials"),
+					opts,
+				)
+			} else {
+				googleP, err = google.NewProvider(
+					ctx,
+					viper.GetUint64("max-results"),
+					viper.GetString("project"),
+					viper.GetString("region"),
+					viper.GetString("credentials"),
+					opts,
+				)
+			}
 			if err != nil {
 				return err
 			}
 
+			// Resolve the aliases of the resource types
+			googleInclude, err := resolveGoogleResourceTypes(include)
+			if err != nil {
+				return errors.Wrap(err, "invalid --include")
+			}
+			googleExclude, err := resolveGoogleResourceTypes(exclude)
+			if err != nil {
+				return errors.Wrap(err, "invalid --exclude")
+			}
+			for _, rt := range googleInclude {
+				if !google.IsExperimental(rt) {
+					continue
+				}
+				msg := fmt.Sprintf("%s is experimental, its import may be incomplete", rt)
+				if !viper.GetBool("experimental") {
+					msg = fmt.Sprintf("%s is experimental and is only imported with --experimental", rt)
+				}
+				fmt.Fprintf(logsOut, "Warning: %s\n", msg)
+				logger.Log("msg", msg)
+			}
+
 			f := &filter.Filter{
-				Tags:    tags,
-				Include: include,
-				Exclude: exclude,
-				Targets: targets,
+				Tags:       tags,
+				Include:    googleInclude,
+				Exclude:    googleExclude,
+				Targets:    targets,
+				Network:    viper.GetString("network"),
+				NamePrefix: viper.GetString("name-prefix"),
+				Status:     viper.GetStringSlice("status"),
+			}
+
+			if viper.GetBool("preflight") {
+				logger.Log("msg", "checking the permissions")
+				if err := googlePreflight(ctx, googleP, f); err != nil {
+					return err
+				}
 			}
 
 			var hclW, stateW writer.Writer
-			options, err := getWriterOptions()
+			source := fmt.Sprintf("the GCP project %s", viper.GetString("project"))
+			if allProjects {
+				source = "the GCP projects of the credentials"
+			}
+			options, err := getWriterOptions(source)
 			if err != nil {
 				return err
 			}
@@ -92,12 +251,20 @@ var (
 				stateW = state.NewWriter(stateOut, options)
 			}
 
+			if importsOut != nil {
+				logger.Log("msg", "initializing Import blocks writer")
+				stateW = writer.NewMulti(stateW, imports.NewWriter(importsOut, options))
+			}
+
 			logger.Log("msg", "importing")
 
 			fmt.Fprintf(logsOut, "Starting Terracognita with version %s\n", Version)
 			logger.Log("msg", "starting terracognita", "version", Version)
 			err = provider.Import(ctx, googleP, hclW, stateW, f, logsOut)
 			if err != nil {
+				if perr := postRunEDeadline(cmd, args, err); perr != nil {
+					return perr
+				}
 				return errors.Wrap(err, "could not import from google")
 			}
 
@@ -106,17 +273,120 @@ var (
 	}
 )
 
+// resolveGoogleResourceTypes returns the resource types of the
+// names, which can be aliases (ex: vm for google_compute_instance)
+func resolveGoogleResourceTypes(names []string) ([]string, error) {
+	rts := make([]string, 0, len(names))
+	for _, n := range names {
+		rt, err := google.ResolveResourceType(n)
+		if err != nil {
+			return nil, err
+		}
+		rts = append(rts, rt)
+	}
+	return rts, nil
+}
+
+// googleMandatoryLabels returns the tags of the --mandatory-labels,
+// which is meant to be set with the env so its value is split on
+// commas as the flag
+func googleMandatoryLabels() ([]tag.Tag, error) {
+	labels := getStringSlice("mandatory-labels")
+	tags := make([]tag.Tag, 0, len(labels))
+	for _, t := range labels {
+		tg, err := tag.New(t)
+		if err != nil {
+			return nil, fmt.Errorf("invalid format for --mandatory-labels with value %q: %w", t, err)
+		}
+		tags = append(tags, tg)
+	}
+	return tags, nil
+}
+
+// googlePreflighter is the Google Provider
+// which permissions can be checked
+type googlePreflighter interface {
+	Preflight(ctx context.Context, types []google.ResourceType) ([]google.PermissionIssue, error)
+}
+
+// googlePreflight checks the permissions needed to import the
+// resource types of the f, the missing ones are written to the
+// logsOut and fail the import before anything is read
+func googlePreflight(ctx context.Context, p provider.Provider, f *filter.Filter) error {
+	pf, ok := p.(googlePreflighter)
+	if !ok {
+		return nil
+	}
+
+	types := make([]google.ResourceType, 0)
+	for _, t := range p.ResourceTypes() {
+		if !f.IsIncluded(t) || f.IsExcluded(t) {
+			continue
+		}
+		rt, err := google.ResourceTypeString(t)
+		if err != nil {
+			return err
+		}
+		types = append(types, rt)
+	}
+
+	issues, err := pf.Preflight(ctx, types)
+	if err != nil {
+		return errors.Wrap(err, "could not check the permissions")
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+
+	for _, i := range issues {
+		fmt.Fprintf(logsOut, "Missing permissions on the project %s to import %s: %s\n", i.Project, i.ResourceType, strings.Join(i.Permissions, ", "))
+	}
+	return errors.Errorf("missing permissions to import %d resource types, grant them or --exclude the types", len(issues))
+}
+
 func init() {
 	googleCmd.AddCommand(googleResourcesCmd)
 
 	// Required flags
-	googleCmd.Flags().String("credentials", "", "path to the JSON credential (required)")
 	googleCmd.Flags().String("project", "", "project (required)")
 	googleCmd.Flags().String("region", "", "region (required)")
 
+	// Discovery flags
+	googleCmd.Flags().Bool("all-projects", false, "import from all the active projects the credentials have access to instead of the --project, which can not be used with it. The projects are read at the same time so the quotas of the credentials are shared between them")
+	googleCmd.Flags().StringSlice("projects-include", []string{}, "List of glob patterns of the IDs of the projects to import with --all-projects (ex: prod-*), by default all of them")
+	googleCmd.Flags().StringSlice("projects-exclude", []string{}, "List of glob patterns of the IDs of the projects to not import with --all-projects (ex: sandbox-*)")
+
 	// Filter flags
 	googleCmd.Flags().StringSliceVarP(&tags, "labels", "t", []string{}, "List of labels to filter with format 'NAME:VALUE'")
+	googleCmd.Flags().StringSlice("mandatory-labels", []string{}, "List of labels with format 'NAME:VALUE' that the resources always have to have, ANDed with the --labels. Meant to be set with the env (ex: MANDATORY_LABELS=env:prod,team:web) as a guardrail")
+	googleCmd.Flags().String("network", "", "Name of the network to which the resources have to be attached, only applies to the resource types with a network (google_compute_instance, google_compute_forwarding_rule, google_compute_global_forwarding_rule and the network endpoint groups)")
+	googleCmd.Flags().String("name-prefix", "", "Prefix that the name of the resources has to have to be imported, the resources without a name of their own (ex: google_folder_iam_policy) are always imported")
+	googleCmd.Flags().StringSlice("status", []string{}, "List of statuses of which the resources have to have one to be imported (ex: RUNNING). Each resource type only uses the ones valid for it: google_compute_instance (PROVISIONING, STAGING, RUNNING, STOPPING, SUSPENDING, SUSPENDED, REPAIRING, TERMINATED), google_compute_disk (CREATING, RESTORING, FAILED, READY, DELETING), google_filestore_instance (CREATING, READY, REPAIRING, DELETING, ERROR), google_dataproc_cluster (CREATING, RUNNING, ERROR, DELETING, UPDATING, STOPPING, STOPPED, STARTING) and google_sql_database_instance (RUNNABLE, SUSPENDED, PENDING_DELETE, PENDING_CREATE, MAINTENANCE, FAILED)")
+	googleCmd.Flags().StringArrayVar(&googleRawFilters, "raw-filter", []string{}, "Filter expression with format 'TYPE:FILTER' passed as it is to the List call of the resource type, using the Google API syntax (ex: 'google_compute_instance:status = RUNNING'). It's ANDed with the --labels")
 
 	// Optional flags
-	googleCmd.Flags().Uint64("max-results", 500, "max results to fetch when pagination is used")
+	googleCmd.Flags().String("credentials", "", "path to the JSON credential. If not set the JSON content of the env GOOGLE_CREDENTIALS_JSON is used and if it's not set either the Application Default Credentials")
+	googleCmd.Flags().Uint64("max-results", 500, "max results to fetch on each page when pagination is used, between 0 and 500 where 0 uses the default of each API. Higher values need less requests but use more quota per request")
+	googleCmd.Flags().Int("max-concurrency", 8, "max requests done at the same time to each Google API, shared by all the projects with --all-projects")
+	googleCmd.Flags().StringToIntVar(&googleAPIConcurrency, "api-concurrency", map[string]int{}, "Overrides the --max-concurrency of some Google APIs with the format 'API=N' (ex: compute=4,storage=16), the API names are the ones of --endpoints")
+	googleCmd.Flags().Int("max-resources-per-type", 0, "max resources to import of each type, only meant to sample a project while exploring it (0 means unlimited)")
+	googleCmd.Flags().Int("resources-threshold", 0, "max resources listed of each type before failing the import, as a guardrail to narrow the filters on big projects (0 means unlimited)")
+	googleCmd.Flags().Bool("resources-threshold-warn", false, "only warn, at the end of the import, about the types over the --resources-threshold instead of failing")
+	googleCmd.Flags().String("organization", "", "organization ID to import the resources that live on it, like the organization IAM custom roles, the tag keys and values or the access policies")
+	googleCmd.Flags().String("folder", "", "folder ID to import the resources that live on it, like the folder IAM policy")
+	googleCmd.Flags().String("customer", "", "Cloud Identity customer ID (ex: C0123abcd) to import its groups and memberships, the credentials need permissions on the organization")
+	googleCmd.Flags().String("access-policy", "", "Access Context Manager policy ID to import its access levels and service perimeters, if not set the policies of the --organization are used")
+	googleCmd.Flags().Int("provider-version", 0, "major version of the Google Terraform provider (ex: 4) for which the resources are written, so the resource types renamed on it use their new name and it is required on the HCL. By default the version used to read them (3)")
+	googleCmd.Flags().StringSlice("zones", []string{}, "List of zones of the region from which to import the zonal resources (ex: us-central1-a,us-central1-b), by default all the zones of the region are discovered and used")
+	googleCmd.Flags().String("billing-account", "", "billing account ID (ex: 012345-6789AB-CDEF01) to import the resources that live on it, like the billing account IAM policy, the credentials need permissions on it")
+	googleCmd.Flags().String("user-agent", "terracognita", "User-Agent of the requests done to the Google APIs, useful to identify them on the quotas and audit logs")
+	googleCmd.Flags().String("proxy", "", "URL of the HTTP(S) or SOCKS5 proxy used to request the Google APIs (ex: http://proxy:3128), it has to be reachable. Terraform uses the HTTPS_PROXY env to read the resources so it has to be set too")
+	googleCmd.Flags().String("ca-file", "", "Path to a PEM file with the certificates of the CAs to trust, on top of the system ones, when requesting the Google APIs")
+	googleCmd.Flags().Bool("log-requests", false, "Logs each request done to the Google APIs with the status and latency of the response, it needs the -v or -d to be shown. The credentials are redacted")
+	googleCmd.Flags().Bool("experimental", false, "import also the resource types which import is not stable yet, they are marked as experimental on 'terracognita google resources'")
+	googleCmd.Flags().Bool("preflight", false, "check that the credentials have the permissions on the project needed to list the resource types to import, and fail if not, before reading any resource. The types that do not live on the project (ex: google_organization_iam_custom_role) are not checked")
+	googleCmd.Flags().Bool("strict-apis", false, "fail if one of the Google APIs is disabled on the project instead of skipping its resources")
+	googleCmd.Flags().String("existing-state", "", "path to an existing TFState, the resources already on it are skipped so only the new ones are imported")
+	googleCmd.Flags().StringToStringVar(&googleEndpoints, "endpoints", map[string]string{}, "Overrides the base URL of the Google APIs with the format 'API=URL' (ex: compute=https://compute.p.googleapis.com/compute/v1/). The API '*' applies to all the APIs only changing the host, where '{api}' is replaced by the API name")
+	googleCmd.Flags().StringToStringVar(&googleEmulators, "emulators", map[string]string{}, "Hosts of the emulators of the Google APIs to import from, with the format 'API=HOST:PORT' (ex: pubsub=localhost:8085), the API names are the ones of --endpoints. The PUBSUB_EMULATOR_HOST and STORAGE_EMULATOR_HOST envs are used by default. The requests to the emulators are not authenticated, and without credentials none are")
 }
@@ -13,6 +13,10 @@ var (
 		Short: "List of all the Google supported Resources",
 		Run: func(cmd *cobra.Command, args []string) {
 			for _, r := range google.ResourceTypeStrings() {
+				if google.IsExperimental(r) {
+					fmt.Println(r, "(experimental)")
+					continue
+				}
 				fmt.Println(r)
 			}
 		},
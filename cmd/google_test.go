@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/tag"
+)
+
+func TestGoogleMandatoryLabels(t *testing.T) {
+	defer viper.Reset()
+	initViper()
+	require.NoError(t, viper.BindPFlag("mandatory-labels", googleCmd.Flags().Lookup("mandatory-labels")))
+
+	t.Run("Env", func(t *testing.T) {
+		t.Setenv("MANDATORY_LABELS", "env:prod, team:x")
+
+		tags, err := googleMandatoryLabels()
+		require.NoError(t, err)
+		assert.Equal(t, []tag.Tag{{Name: "env", Value: "prod"}, {Name: "team", Value: "x"}}, tags)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Setenv("MANDATORY_LABELS", "env:prod,team")
+
+		_, err := googleMandatoryLabels()
+		assert.True(t, errors.Is(err, errcode.ErrTagInvalidForamt))
+	})
+
+	// The flag is set last as it
+	// takes precedence over the env
+	t.Run("Flag", func(t *testing.T) {
+		require.NoError(t, googleCmd.Flags().Set("mandatory-labels", "env:prod,team:x"))
+
+		tags, err := googleMandatoryLabels()
+		require.NoError(t, err)
+		assert.Equal(t, []tag.Tag{{Name: "env", Value: "prod"}, {Name: "team", Value: "x"}}, tags)
+	})
+}
@@ -1,18 +1,25 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"os/signal"
 	"path"
 	"path/filepath"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/adrg/xdg"
 	"github.com/cycloidio/mxwriter"
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/hcl"
 	"github.com/cycloidio/terracognita/log"
 	"github.com/cycloidio/terracognita/writer"
 	"github.com/spf13/cobra"
@@ -21,9 +28,10 @@ import (
 )
 
 var (
-	isHCLDir bool
-	hclOut   io.ReadWriter
-	stateOut io.Writer
+	isHCLDir   bool
+	hclOut     io.ReadWriter
+	stateOut   io.Writer
+	importsOut io.Writer
 
 	closeOut = make([]io.Closer, 0, 0)
 
@@ -73,6 +81,25 @@ func requiredStringFlags(names ...string) error {
 	return nil
 }
 
+// getStringSlice returns the list of the key. When it's
+// set with the env viper returns it as a string, which
+// viper.GetStringSlice would split on whitespaces, so it's
+// split on commas as the flags are
+func getStringSlice(key string) []string {
+	v, ok := viper.Get(key).(string)
+	if !ok {
+		return viper.GetStringSlice(key)
+	}
+	if v == "" {
+		return []string{}
+	}
+	list := strings.Split(v, ",")
+	for i := range list {
+		list[i] = strings.TrimSpace(list[i])
+	}
+	return list
+}
+
 func preRunEOutput(cmd *cobra.Command, args []string) error {
 	// Initializes/Validates the HCL and TFSTATE flags
 	if module := viper.GetString("module"); module != "" {
@@ -104,13 +131,13 @@ func preRunEOutput(cmd *cobra.Command, args []string) error {
 		// Clean the module dir
 		err = os.RemoveAll(module)
 		if err != nil {
-			return err
+			return outputDirErr(module, err)
 		}
 
 		// Recreate it just if it was not created
 		// RemoveAll will not return error if
 		// it does not exists
-		err = os.MkdirAll(module, 0700)
+		err = mkdirOutput(module)
 		if err != nil {
 			return err
 		}
@@ -126,6 +153,10 @@ func preRunEOutput(cmd *cobra.Command, args []string) error {
 		hasExt := filepath.Ext(hcl) != ""
 		if (err == nil && !fi.IsDir()) || hasExt {
 			isHCLDir = false
+			err = mkdirOutput(filepath.Dir(hcl))
+			if err != nil {
+				return err
+			}
 		} else {
 			isHCLDir = true
 			// It means is a existing directory
@@ -144,13 +175,13 @@ func preRunEOutput(cmd *cobra.Command, args []string) error {
 			// Clean the module dir
 			err = os.RemoveAll(hcl)
 			if err != nil {
-				return err
+				return outputDirErr(hcl, err)
 			}
 
 			// Recreate it just if it was not created
 			// RemoveAll will not return error if
 			// it does not exists
-			err = os.MkdirAll(hcl, 0700)
+			err = mkdirOutput(hcl)
 			if err != nil {
 				return err
 			}
@@ -159,6 +190,10 @@ func preRunEOutput(cmd *cobra.Command, args []string) error {
 		hclOut = mxwriter.NewMux()
 	}
 	if viper.GetString("tfstate") != "" {
+		err := mkdirOutput(filepath.Dir(viper.GetString("tfstate")))
+		if err != nil {
+			return err
+		}
 		f, err := os.OpenFile(viper.GetString("tfstate"), os.O_APPEND|os.O_TRUNC|os.O_RDWR|os.O_CREATE, 0644)
 		if err != nil {
 			return fmt.Errorf("could not OpenFile %s because: %s", viper.GetString("tfstate"), err)
@@ -167,8 +202,35 @@ func preRunEOutput(cmd *cobra.Command, args []string) error {
 		closeOut = append(closeOut, f)
 	}
 
-	if viper.GetString("tfstate") == "" && viper.GetString("hcl") == "" && viper.GetString("module") == "" {
-		return fmt.Errorf("one of --module, --hcl  or --tfstate are required")
+	if viper.GetString("import-blocks") != "" {
+		err := mkdirOutput(filepath.Dir(viper.GetString("import-blocks")))
+		if err != nil {
+			return err
+		}
+		f, err := os.OpenFile(viper.GetString("import-blocks"), os.O_APPEND|os.O_TRUNC|os.O_RDWR|os.O_CREATE, 0644)
+		if err != nil {
+			return fmt.Errorf("could not OpenFile %s because: %s", viper.GetString("import-blocks"), err)
+		}
+		importsOut = f
+		closeOut = append(closeOut, f)
+	}
+
+	if tf := viper.GetString("targets-file"); tf != "" {
+		f, err := os.Open(tf)
+		if err != nil {
+			return fmt.Errorf("could not Open %s because: %s", tf, err)
+		}
+		defer f.Close()
+
+		ts, err := filter.ReadTargets(f)
+		if err != nil {
+			return fmt.Errorf("invalid --targets-file %s: %w", tf, err)
+		}
+		targets = append(targets, ts...)
+	}
+
+	if viper.GetString("tfstate") == "" && viper.GetString("hcl") == "" && viper.GetString("module") == "" && viper.GetString("import-blocks") == "" {
+		return fmt.Errorf("one of --module, --hcl, --tfstate or --import-blocks are required")
 	}
 	return nil
 }
@@ -200,9 +262,9 @@ func postRunEOutput(cmd *cobra.Command, args []string) error {
 				filep string
 			)
 			if k == writer.ModuleCategoryKey {
-				filep = filepath.Join(m, "module.tf")
+				filep = filepath.Join(m, "module"+hclExt())
 			} else {
-				filep = filepath.Join(m, mdir, fmt.Sprintf("%s.tf", k))
+				filep = filepath.Join(m, mdir, k+hclExt())
 			}
 
 			f, err := os.OpenFile(filep, os.O_APPEND|os.O_TRUNC|os.O_RDWR|os.O_CREATE, 0644)
@@ -212,14 +274,14 @@ func postRunEOutput(cmd *cobra.Command, args []string) error {
 			io.Copy(f, dm.Read(k))
 			f.Close()
 		}
-	} else if hcl := viper.GetString("hcl"); hcl != "" {
+	} else if hclPath := viper.GetString("hcl"); hclPath != "" {
 		dm, err := mxwriter.NewDemux(hclOut)
 		if err != nil {
 			return err
 		}
 		if isHCLDir {
 			for _, k := range dm.Keys() {
-				filep := filepath.Join(hcl, fmt.Sprintf("%s.tf", k))
+				filep := filepath.Join(hclPath, k+hclExt())
 
 				f, err := os.OpenFile(filep, os.O_APPEND|os.O_TRUNC|os.O_RDWR|os.O_CREATE, 0644)
 				if err != nil {
@@ -233,7 +295,30 @@ func postRunEOutput(cmd *cobra.Command, args []string) error {
 			if err != nil {
 				return fmt.Errorf("could not OpenFile %s because: %s", viper.GetString("hcl"), err)
 			}
-			io.Copy(f, hclOut)
+			if viper.GetBool("hcl-json") {
+				// Each category is a JSON document so
+				// they have to be merged on only one
+				docs := make([][]byte, 0, len(dm.Keys()))
+				for _, k := range dm.Keys() {
+					b, err := ioutil.ReadAll(dm.Read(k))
+					if err != nil {
+						f.Close()
+						return err
+					}
+					docs = append(docs, b)
+				}
+				b, err := hcl.MergeJSON(docs...)
+				if err != nil {
+					f.Close()
+					return err
+				}
+				if _, err := f.Write(b); err != nil {
+					f.Close()
+					return fmt.Errorf("could not Write %s because: %s", viper.GetString("hcl"), err)
+				}
+			} else {
+				io.Copy(f, hclOut)
+			}
 			f.Close()
 		}
 	}
@@ -241,33 +326,85 @@ func postRunEOutput(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-// getWriterOptions will initialize the common writer.Options from the flags
-func getWriterOptions() (*writer.Options, error) {
+// mkdirOutput creates the output directory dir
+// and its parents if they are missing
+func mkdirOutput(dir string) error {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return outputDirErr(dir, err)
+	}
+	return nil
+}
+
+// outputDirErr returns a clear error when the user
+// has no permissions to write on the output directory
+func outputDirErr(dir string, err error) error {
+	if os.IsPermission(err) {
+		return fmt.Errorf("permission denied to write on the output directory %s: %w", dir, err)
+	}
+	return fmt.Errorf("could not write on the output directory %s: %w", dir, err)
+}
+
+// hclExt returns the extension of the HCL files,
+// which is .tf.json with the --hcl-json
+func hclExt() string {
+	if viper.GetBool("hcl-json") {
+		return ".tf.json"
+	}
+	return ".tf"
+}
+
+// importContext returns the context to use for the whole
+// import, with the --timeout as deadline if it's set and
+// the --run-id to add to the logs.
+// It's canceled on the first SIGINT or SIGTERM so the import
+// stops and writes what it already has, a second one exits
+// right away as the default handling is then restored
+func importContext() (context.Context, context.CancelFunc) {
+	ctx := log.WithRunID(context.Background(), viper.GetString("run-id"))
+
+	var cancel context.CancelFunc
+	if t := viper.GetDuration("timeout"); t > 0 {
+		ctx, cancel = context.WithTimeout(ctx, t)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// postRunEDeadline writes the outputs imported before the
+// deadline was exceeded or the import was interrupted, as
+// the PostRunE is not called when the RunE returns an error
+func postRunEDeadline(cmd *cobra.Command, args []string, err error) error {
+	if !errors.Is(err, errcode.ErrImportDeadline) && !errors.Is(err, errcode.ErrImportInterrupted) {
+		return nil
+	}
+
+	return postRunEOutput(cmd, args)
+}
+
+// getWriterOptions will initialize the common writer.Options from the flags,
+// the source is where the resources are imported from (ex: the project p)
+func getWriterOptions(source string) (*writer.Options, error) {
 	var module string
 	var mv = make(map[string]struct{})
 	if m := viper.GetString("module"); m != "" {
 		module = filepath.Base(m)
 
 		if pmv := viper.GetString("module-variables"); pmv != "" {
-			b, err := ioutil.ReadFile(pmv)
+			values, err := readListsFile("module-variables", pmv)
 			if err != nil {
-				return nil, fmt.Errorf("could not ReadFile on path %q: %w", pmv, err)
-			}
-
-			var values map[string][]string
-			switch filepath.Ext(pmv) {
-			case ".yml", "yaml":
-				err := yaml.Unmarshal(b, &values)
-				if err != nil {
-					return nil, fmt.Errorf("invalid YAML on module-variables file %s: %w", pmv, err)
-				}
-			case ".json":
-				err = json.Unmarshal(b, &values)
-				if err != nil {
-					return nil, fmt.Errorf("invalid JSON on module-variables file %s: %w", pmv, err)
-				}
-			default:
-				return nil, fmt.Errorf("invalid module-variables %s, only supported extensions are yaml/yml/json", pmv)
+				return nil, err
 			}
 
 			for k, v := range values {
@@ -278,14 +415,102 @@ func getWriterOptions() (*writer.Options, error) {
 		}
 	}
 
+	var fg = make(map[string]string)
+	if pfg := viper.GetString("hcl-file-groups"); pfg != "" {
+		values, err := readListsFile("hcl-file-groups", pfg)
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range values {
+			for _, vv := range v {
+				if g, ok := fg[vv]; ok {
+					return nil, fmt.Errorf("invalid hcl-file-groups file %s, the type %q is on the groups %q and %q", pfg, vv, g, k)
+				}
+				fg[vv] = k
+			}
+		}
+	}
+
+	fileName, err := hclFileName()
+	if err != nil {
+		return nil, err
+	}
+
+	var comment string
+	if viper.GetBool("hcl-source-comment") {
+		comment = "Imported by Terracognita"
+		if Version != "" {
+			comment = fmt.Sprintf("%s %s", comment, Version)
+		}
+		comment = fmt.Sprintf("%s from %s on %s", comment, source, time.Now().UTC().Format(time.RFC3339))
+	}
+
 	return &writer.Options{
-		Interpolate:      viper.GetBool("interpolate"),
-		Module:           module,
-		ModuleVariables:  mv,
-		HCLProviderBlock: viper.GetBool("hcl-provider-block"),
+		HCLComment:         comment,
+		Interpolate:        viper.GetBool("interpolate"),
+		Module:             module,
+		ModuleVariables:    mv,
+		HCLProviderBlock:   viper.GetBool("hcl-provider-block"),
+		HCLFilePerType:     viper.GetBool("hcl-file-per-type"),
+		HCLFilePerLocation: viper.GetBool("hcl-file-per-location"),
+		HCLFileGroups:      fg,
+		HCLFileName:        fileName,
+		HCLJSON:            viper.GetBool("hcl-json"),
 	}, nil
 }
 
+// hclFileName returns the --hcl-file-name template with the
+// {project} expanded and without the extension, as the
+// writer uses it as the category of the resources
+func hclFileName() (string, error) {
+	fn := viper.GetString("hcl-file-name")
+	if fn == "" {
+		return "", nil
+	}
+	if strings.ContainsAny(fn, `/\\`) {
+		return "", fmt.Errorf("invalid --hcl-file-name %q, it has to be a file name and not a path", fn)
+	}
+	if strings.Contains(fn, "{project}") {
+		if viper.GetBool("all-projects") {
+			return "", fmt.Errorf("invalid --hcl-file-name %q, the {project} can not be used with --all-projects", fn)
+		}
+		p := viper.GetString("project")
+		if p == "" {
+			return "", fmt.Errorf("invalid --hcl-file-name %q, the {project} is only supported by Google", fn)
+		}
+		fn = strings.ReplaceAll(fn, "{project}", p)
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(fn, ".json"), ".tf"), nil
+}
+
+// readListsFile reads the file on path p of the flag f which
+// has to be a YAML/JSON with the format map[string][]string
+func readListsFile(f, p string) (map[string][]string, error) {
+	b, err := ioutil.ReadFile(p)
+	if err != nil {
+		return nil, fmt.Errorf("could not ReadFile on path %q: %w", p, err)
+	}
+
+	var values map[string][]string
+	switch filepath.Ext(p) {
+	case ".yml", "yaml":
+		err := yaml.Unmarshal(b, &values)
+		if err != nil {
+			return nil, fmt.Errorf("invalid YAML on %s file %s: %w", f, p, err)
+		}
+	case ".json":
+		err = json.Unmarshal(b, &values)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON on %s file %s: %w", f, p, err)
+		}
+	default:
+		return nil, fmt.Errorf("invalid %s %s, only supported extensions are yaml/yml/json", f, p)
+	}
+
+	return values, nil
+}
+
 func init() {
 	cobra.OnInitialize(initViper)
 	RootCmd.AddCommand(awsCmd)
@@ -299,12 +524,33 @@ func init() {
 	RootCmd.PersistentFlags().String("tfstate", "", "TFState output file")
 	_ = viper.BindPFlag("tfstate", RootCmd.PersistentFlags().Lookup("tfstate"))
 
+	RootCmd.PersistentFlags().String("import-blocks", "", "Terraform (1.5+) import blocks output file, with an 'import {}' block for each resource. It can be used alongside the --hcl or with 'terraform plan -generate-config-out'")
+	_ = viper.BindPFlag("import-blocks", RootCmd.PersistentFlags().Lookup("import-blocks"))
+
 	RootCmd.PersistentFlags().String("module", "", "Generates the output in module format into the directory specified. With this flag (--module) the --hcl is ignored and will be generated inside of the module")
 	_ = viper.BindPFlag("module", RootCmd.PersistentFlags().Lookup("module"))
 
 	RootCmd.PersistentFlags().String("module-variables", "", "Path to a file containing the list of attributes to use as variables when building the module. The format is a JSON/YAML, more information on https://github.com/cycloidio/terracognita#modules")
 	_ = viper.BindPFlag("module-variables", RootCmd.PersistentFlags().Lookup("module-variables"))
 
+	RootCmd.PersistentFlags().Bool("hcl-file-per-type", false, "Writes each resource type on its own file named after the type (ex: compute_instance.tf) instead of grouping them by category. Only used when --hcl is a directory or with --module")
+	_ = viper.BindPFlag("hcl-file-per-type", RootCmd.PersistentFlags().Lookup("hcl-file-per-type"))
+
+	RootCmd.PersistentFlags().Bool("hcl-file-per-location", false, "Writes the resources with a zone or region on a file named after it (ex: europe-west1-b.tf), the others keep their file. Only supported by Google. Has priority over --hcl-file-per-type and is only used when --hcl is a directory or with --module")
+	_ = viper.BindPFlag("hcl-file-per-location", RootCmd.PersistentFlags().Lookup("hcl-file-per-location"))
+
+	RootCmd.PersistentFlags().String("hcl-file-groups", "", "Path to a JSON/YAML file with the format 'FILE: [TYPE, ...]' to choose in which file each resource type is written (ex: 'network: [google_compute_network, google_compute_firewall]'). Has priority over --hcl-file-per-type and is only used when --hcl is a directory or with --module")
+	_ = viper.BindPFlag("hcl-file-groups", RootCmd.PersistentFlags().Lookup("hcl-file-groups"))
+
+	RootCmd.PersistentFlags().String("hcl-file-name", "", "Template of the name of the files the resources are written on, with the {provider}, {project} (only Google), {type}, {category} and {location} placeholders (ex: '{project}-{type}.tf'). Has priority over --hcl-file-per-type and --hcl-file-per-location and is only used when --hcl is a directory or with --module")
+	_ = viper.BindPFlag("hcl-file-name", RootCmd.PersistentFlags().Lookup("hcl-file-name"))
+
+	RootCmd.PersistentFlags().Bool("hcl-json", false, "Writes the configuration on the Terraform JSON syntax instead of HCL, the files of the --hcl directory or --module have the .tf.json extension")
+	_ = viper.BindPFlag("hcl-json", RootCmd.PersistentFlags().Lookup("hcl-json"))
+
+	RootCmd.PersistentFlags().Bool("hcl-source-comment", false, "Writes a comment on top of each HCL resource with the version of Terracognita, from where it was imported and when")
+	_ = viper.BindPFlag("hcl-source-comment", RootCmd.PersistentFlags().Lookup("hcl-source-comment"))
+
 	RootCmd.PersistentFlags().StringSliceVarP(&include, "include", "i", []string{}, "List of resources to import, this names are the ones on TF (ex: aws_instance). If not set then means that all the resources will be imported")
 	_ = viper.BindPFlag("include", RootCmd.PersistentFlags().Lookup("include"))
 
@@ -314,6 +560,15 @@ func init() {
 	RootCmd.PersistentFlags().StringSliceVar(&targets, "target", []string{}, "List of resources to import via ID, those IDs are the ones documented on Terraform that are needed to Import. The format is 'aws_instance.ID'")
 	_ = viper.BindPFlag("target", RootCmd.PersistentFlags().Lookup("target"))
 
+	RootCmd.PersistentFlags().String("targets-file", "", "Path to a file with one resource to import per line with the format 'TYPE,ID' (ex: 'aws_instance,i-0123'), the lines starting with '#' are ignored. They are added to the --target so only those are imported, without listing the others")
+	_ = viper.BindPFlag("targets-file", RootCmd.PersistentFlags().Lookup("targets-file"))
+
+	RootCmd.PersistentFlags().Duration("timeout", 0, "Max duration of the whole import (ex: 30m), when it's exceeded the import stops and the resources already imported are written. 0 means no timeout")
+	_ = viper.BindPFlag("timeout", RootCmd.PersistentFlags().Lookup("timeout"))
+
+	RootCmd.PersistentFlags().String("run-id", "", "ID added as 'run_id' to all the logs of the import, to correlate them with the ones of other services")
+	_ = viper.BindPFlag("run-id", RootCmd.PersistentFlags().Lookup("run-id"))
+
 	RootCmd.PersistentFlags().BoolP("verbose", "v", false, "Activate the verbose mode")
 	_ = viper.BindPFlag("verbose", RootCmd.PersistentFlags().Lookup("verbose"))
 
@@ -8,6 +8,7 @@ var (
 	ErrProviderResourceNotRead       = errors.New("the resource did not return an ID")
 	ErrProviderResourceDoNotMatchTag = errors.New("the resource does not match the required tags")
 	ErrProviderResourceAutogenerated = errors.New("the resource is autogenerated and should not be imported")
+	ErrProviderResourcesThreshold    = errors.New("the resource type has more resources than the threshold")
 
 	ErrCacheKeyNotFound        = errors.New("the key used to search was not found")
 	ErrCacheKeyAlreadyExisting = errors.New("the key already exists on the cache")
@@ -25,4 +26,12 @@ var (
 	// ErrProviderAPI will be raised when an error occurs provider side while
 	// using its APIs (authorization error, unavailable operation, ...)
 	ErrProviderAPI = errors.New("error while requesting the provider APIs")
+
+	// ErrImportDeadline will be raised when the import took longer than
+	// the deadline, the resources imported before it are still written
+	ErrImportDeadline = errors.New("the import deadline was exceeded, only the resources imported before it were written")
+
+	// ErrImportInterrupted will be raised when the import was interrupted
+	// (SIGINT, SIGTERM), the resources imported before it are still written
+	ErrImportInterrupted = errors.New("the import was interrupted, only the resources imported before it were written")
 )
@@ -18,6 +18,23 @@ type Filter struct {
 	Exclude []string
 	Targets []string
 
+	// Network is the name of the network to which the
+	// resources have to be attached. Only the resource
+	// types that have a network support it, the others
+	// ignore it
+	Network string
+
+	// NamePrefix is the prefix that the name of the
+	// resources has to have to be imported. If empty
+	// all the resources are imported
+	NamePrefix string
+
+	// Status is the list of statuses (ex: RUNNING) of which
+	// the resources have to have one to be imported. Each
+	// resource type only uses the ones valid for it and if
+	// none is valid all its resources are imported
+	Status []string
+
 	exclude map[string]struct{}
 	include map[string]struct{}
 }
@@ -108,7 +125,10 @@ func (f *Filter) String() string {
 	Include: %s,
 	Exclude: %s,
 	Targets: %s,
-`, f.Tags, f.Include, f.Exclude, f.Targets)
+	Network: %s,
+	NamePrefix: %s,
+	Status: %s,
+`, f.Tags, f.Include, f.Exclude, f.Targets, f.Network, f.NamePrefix, f.Status)
 }
 
 // calculateExcludeMap makes a map of the Exclude so
@@ -0,0 +1,57 @@
+package filter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/pkg/errors"
+)
+
+// ReadTargets reads from r a list of Targets with one 'TYPE,ID'
+// pair per line (ex: 'aws_instance,i-0123') and returns them
+// with the format of the Filter.Targets ('aws_instance.i-0123').
+// The empty lines and the ones starting with '#' are ignored.
+// If any line is malformed an error with all of them is returned
+func ReadTargets(r io.Reader) ([]string, error) {
+	var (
+		targets   []string
+		malformed []string
+		n         int
+	)
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		n++
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// The IDs can have ',' so only the first one is the separator
+		parts := strings.SplitN(line, ",", 2)
+		if len(parts) != 2 {
+			malformed = append(malformed, fmt.Sprintf("line %d: %q", n, line))
+			continue
+		}
+
+		t, id := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if t == "" || id == "" || strings.ContainsAny(t, ". \t") {
+			malformed = append(malformed, fmt.Sprintf("line %d: %q", n, line))
+			continue
+		}
+
+		targets = append(targets, fmt.Sprintf("%s.%s", t, id))
+	}
+	if err := s.Err(); err != nil {
+		return nil, errors.Wrap(err, "unable to read the targets")
+	}
+
+	if len(malformed) != 0 {
+		return nil, errors.Wrapf(errcode.ErrFilterTargetsInvalid, "the expected format is 'TYPE,ID' on:\n  %s", strings.Join(malformed, "\n  "))
+	}
+
+	return targets, nil
+}
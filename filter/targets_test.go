@@ -0,0 +1,52 @@
+package filter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadTargets(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		r := strings.NewReader(`
+# The instances to migrate
+aws_instance,i-0123
+ google_compute_instance , my-project/europe-west1-b/vm
+
+google_logging_metric,my-project metric,with,commas
+`)
+		targets, err := filter.ReadTargets(r)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"aws_instance.i-0123",
+			"google_compute_instance.my-project/europe-west1-b/vm",
+			"google_logging_metric.my-project metric,with,commas",
+		}, targets)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		targets, err := filter.ReadTargets(strings.NewReader(""))
+		require.NoError(t, err)
+		assert.Len(t, targets, 0)
+	})
+	t.Run("ErrFilterTargetsInvalid", func(t *testing.T) {
+		r := strings.NewReader(`aws_instance,i-0123
+aws_instance
+aws_instance,
+,i-0123
+aws.instance,i-0123
+`)
+		targets, err := filter.ReadTargets(r)
+		assert.Nil(t, targets)
+		assert.Equal(t, errcode.ErrFilterTargetsInvalid, errors.Cause(err))
+		assert.Contains(t, err.Error(), `line 2: "aws_instance"`)
+		assert.Contains(t, err.Error(), `line 3: "aws_instance,"`)
+		assert.Contains(t, err.Error(), `line 4: ",i-0123"`)
+		assert.Contains(t, err.Error(), `line 5: "aws.instance,i-0123"`)
+		assert.NotContains(t, err.Error(), "line 1")
+	})
+}
@@ -0,0 +1,112 @@
+package google
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/agext/levenshtein"
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/errcode"
+)
+
+// resourceTypePrefix is the prefix of all the ResourceTypes names
+const resourceTypePrefix = "google_"
+
+// maxSuggestions is the max number of close matches
+// suggested when a resource type is not found
+const maxSuggestions = 5
+
+// resourceTypeAliases are common shorthands of the ResourceTypes
+// that can be used instead of their name (ex: on the --include)
+var resourceTypeAliases = map[string]ResourceType{
+	"vm":           ComputeInstance,
+	"gce_instance": ComputeInstance,
+	"instance":     ComputeInstance,
+	"disk":         ComputeDisk,
+	"firewall":     ComputeFirewall,
+	"vpc":          ComputeNetwork,
+	"network":      ComputeNetwork,
+	"lb":           ComputeForwardingRule,
+	"url_map":      ComputeURLMap,
+	"bucket":       StorageBucket,
+	"gcs_bucket":   StorageBucket,
+	"cloudsql":     SQLDatabaseInstance,
+	"sql_instance": SQLDatabaseInstance,
+	"dns_zone":     DNSManagedZone,
+	"dns_record":   DNSRecordSet,
+	"bigtable":     BigtableInstance,
+	"gae":          AppEngineApplication,
+	"workflow":     WorkflowsWorkflow,
+	"composer":     ComposerEnvironment,
+	"airflow":      ComposerEnvironment,
+	"binauthz":     BinaryAuthorizationPolicy,
+}
+
+// ResolveResourceType returns the ResourceType name of the name,
+// which can be the ResourceType name, the name without the
+// 'google_' prefix (ex: compute_instance) or one of the aliases
+// (ex: vm). If it's none of them the error has the closest
+// names as suggestions
+func ResolveResourceType(name string) (string, error) {
+	n := strings.TrimPrefix(strings.ToLower(name), resourceTypePrefix)
+	if rt, err := ResourceTypeString(resourceTypePrefix + n); err == nil {
+		return rt.String(), nil
+	}
+	if rt, ok := resourceTypeAliases[n]; ok {
+		return rt.String(), nil
+	}
+
+	if s := suggestResourceTypes(n); len(s) != 0 {
+		return "", errors.Wrapf(errcode.ErrProviderResourceNotSupported, "type %q, did you mean %s?", name, strings.Join(s, ", "))
+	}
+	return "", errors.Wrapf(errcode.ErrProviderResourceNotSupported, "type %q", name)
+}
+
+// suggestResourceTypes returns the ResourceType names and aliases
+// closest to n, being n without the 'google_' prefix
+func suggestResourceTypes(n string) []string {
+	type suggestion struct {
+		name     string
+		distance int
+	}
+
+	candidates := make(map[string]string)
+	for _, rt := range ResourceTypeValues() {
+		candidates[strings.TrimPrefix(rt.String(), resourceTypePrefix)] = rt.String()
+	}
+	for a, rt := range resourceTypeAliases {
+		candidates[a] = fmt.Sprintf("%s (%s)", a, rt)
+	}
+
+	// The max distance allowed depends on the
+	// length so the short ones are not all close
+	max := len(n) / 3
+	if max < 2 {
+		max = 2
+	}
+
+	suggestions := make([]suggestion, 0)
+	for c, s := range candidates {
+		d := levenshtein.Distance(n, c, nil)
+		if d <= max || (len(n) > 3 && strings.Contains(c, n)) {
+			suggestions = append(suggestions, suggestion{name: s, distance: d})
+		}
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].distance != suggestions[j].distance {
+			return suggestions[i].distance < suggestions[j].distance
+		}
+		return suggestions[i].name < suggestions[j].name
+	})
+
+	if len(suggestions) > maxSuggestions {
+		suggestions = suggestions[:maxSuggestions]
+	}
+	names := make([]string, 0, len(suggestions))
+	for _, s := range suggestions {
+		names = append(names, s.name)
+	}
+	return names
+}
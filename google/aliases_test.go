@@ -0,0 +1,54 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/errcode"
+)
+
+func TestResolveResourceType(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Type     string
+		Expected string
+	}{
+		{Name: "Canonical", Type: "google_compute_instance", Expected: "google_compute_instance"},
+		{Name: "WithoutPrefix", Type: "compute_instance", Expected: "google_compute_instance"},
+		{Name: "Alias", Type: "vm", Expected: "google_compute_instance"},
+		{Name: "AliasWithPrefix", Type: "google_gce_instance", Expected: "google_compute_instance"},
+		{Name: "UpperCase", Type: "GCS_Bucket", Expected: "google_storage_bucket"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			rt, err := ResolveResourceType(tt.Type)
+			require.NoError(t, err)
+			assert.Equal(t, tt.Expected, rt)
+		})
+	}
+
+	t.Run("DidYouMean", func(t *testing.T) {
+		_, err := ResolveResourceType("compute_instanse")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errcode.ErrProviderResourceNotSupported))
+		assert.Contains(t, err.Error(), `type "compute_instanse", did you mean google_compute_instance`)
+	})
+	t.Run("NoSuggestions", func(t *testing.T) {
+		_, err := ResolveResourceType("potato")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errcode.ErrProviderResourceNotSupported))
+		assert.NotContains(t, err.Error(), "did you mean")
+	})
+}
+
+func TestResourceTypeAliases(t *testing.T) {
+	for a, rt := range resourceTypeAliases {
+		_, err := ResourceTypeString(resourceTypePrefix + a)
+		assert.Error(t, err, "the alias %q can not be a resource type name", a)
+		assert.Contains(t, resources, rt)
+	}
+}
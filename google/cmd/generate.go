@@ -10,23 +10,45 @@ import (
 )
 
 var functions = []Function{
-	Function{Resource: "BackendService", Zone: false},
-	Function{Resource: "BackendBucket"},
-	Function{Resource: "Bucket", NoFilter: true, API: "storage", ResourceList: "Buckets"},
-	Function{Resource: "DatabaseInstance", Name: "StorageInstances", API: "sqladmin", ResourceList: "InstancesListResponse", ServiceName: "Instances"},
-	Function{Resource: "Disk", Zone: true},
-	Function{Resource: "Firewall", Zone: false},
-	Function{Resource: "ForwardingRule", Zone: false, Name: "GlobalForwardingRules", ServiceName: "GlobalForwardingRules"},
-	Function{Resource: "ForwardingRule", Region: true},
-	Function{Resource: "HealthCheck", Zone: false},
-	Function{Resource: "Instance", Zone: true},
-	Function{Resource: "InstanceGroup", Zone: true},
-	Function{Resource: "ManagedZone", API: "dns", ResourceList: "ManagedZonesListResponse", NoFilter: true, ItemName: "ManagedZones"},
-	Function{Resource: "Network", Zone: false},
-	Function{Resource: "SslCertificate", Zone: false, Name: "SSLCertificates"},
-	Function{Resource: "TargetHttpProxy", Zone: false, Name: "TargetHTTPProxies", ServiceName: "TargetHttpProxies"},
-	Function{Resource: "TargetHttpsProxy", Zone: false, Name: "TargetHTTPSProxies", ServiceName: "TargetHttpsProxies"},
-	Function{Resource: "UrlMap", Zone: false, Name: "URLMaps"},
+	Function{Resource: "BackendService", Zone: false, Fields: "name,enableCDN,cdnPolicy"},
+	Function{Resource: "BackendBucket", Fields: "name,enableCdn,cdnPolicy"},
+	Function{Resource: "Bucket", NoFilter: true, API: "storage", ResourceList: "Buckets", Fields: "name"},
+	Function{Resource: "DatabaseInstance", Name: "StorageInstances", API: "sqladmin", ResourceList: "InstancesListResponse", ServiceName: "Instances", Fields: "name,state"},
+	Function{Resource: "Disk", Zone: true, Fields: "name,status,resourcePolicies,sourceImage,sourceSnapshot"},
+	Function{Resource: "ExternalVpnGateway", Name: "ExternalVPNGateways", Fields: "name"},
+	Function{Resource: "Firewall", Zone: false, Fields: "name,allowed,denied,direction,priority,sourceRanges,destinationRanges,sourceTags,targetTags,sourceServiceAccounts,targetServiceAccounts"},
+	Function{Resource: "ForwardingRule", Zone: false, Name: "GlobalForwardingRules", ServiceName: "GlobalForwardingRules", Fields: "name,network,target,IPProtocol,portRange"},
+	Function{Resource: "ForwardingRule", Region: true, Fields: "name,network,target,IPProtocol,portRange"},
+	Function{Resource: "HealthCheck", Zone: false, Fields: "name"},
+	Function{Resource: "HealthCheck", Region: true, Name: "RegionHealthChecks", ServiceName: "RegionHealthChecks", Fields: "name"},
+	Function{Resource: "HttpHealthCheck", Name: "HTTPHealthChecks", Fields: "name"},
+	Function{Resource: "HttpsHealthCheck", Name: "HTTPSHealthChecks", Fields: "name"},
+	Function{Resource: "Instance", Zone: true, Fields: "name,status,machineType,networkInterfaces(network),disks(boot,source)"},
+	Function{Resource: "InstanceGroup", Zone: true, Fields: "name,namedPorts"},
+	Function{Resource: "InstanceGroupManager", Zone: true},
+	Function{Resource: "InstanceGroupManager", Region: true, Name: "RegionInstanceGroupManagers", ServiceName: "RegionInstanceGroupManagers"},
+	Function{Resource: "InterconnectAttachment", Region: true, Fields: "name"},
+	Function{Resource: "NetworkEndpointGroup", Name: "GlobalNetworkEndpointGroups", ServiceName: "GlobalNetworkEndpointGroups", Fields: "name,network"},
+	Function{Resource: "NetworkEndpointGroup", Region: true, Name: "RegionNetworkEndpointGroups", ServiceName: "RegionNetworkEndpointGroups", Fields: "name,network,networkEndpointType,cloudRun,appEngine,cloudFunction"},
+	Function{Resource: "ManagedZone", API: "dns", ResourceList: "ManagedZonesListResponse", NoFilter: true, ItemName: "ManagedZones", Fields: "name"},
+	Function{Resource: "Network", Zone: false, Fields: "name,peerings"},
+	Function{Resource: "NodeGroup", Zone: true, Fields: "name"},
+	Function{Resource: "NodeTemplate", Region: true, Fields: "name"},
+	Function{Resource: "Reservation", Zone: true, Fields: "name"},
+	Function{Resource: "ResourcePolicy", Region: true, Name: "ResourcePolicies", ServiceName: "ResourcePolicies", Fields: "name"},
+	Function{Resource: "SslCertificate", Zone: false, Name: "SSLCertificates", Fields: "name"},
+	Function{Resource: "SslCertificate", Region: true, Name: "RegionSSLCertificates", ServiceName: "RegionSslCertificates", Fields: "name"},
+	Function{Resource: "SslPolicy", Name: "SSLPolicies", ServiceName: "SslPolicies", ResourceList: "SslPoliciesList", Fields: "name"},
+	Function{Resource: "TargetHttpProxy", Zone: false, Name: "TargetHTTPProxies", ServiceName: "TargetHttpProxies", Fields: "name"},
+	Function{Resource: "TargetHttpsProxy", Zone: false, Name: "TargetHTTPSProxies", ServiceName: "TargetHttpsProxies", Fields: "name"},
+	Function{Resource: "TargetHttpProxy", Region: true, Name: "RegionTargetHTTPProxies", ServiceName: "RegionTargetHttpProxies", Fields: "name"},
+	Function{Resource: "TargetHttpsProxy", Region: true, Name: "RegionTargetHTTPSProxies", ServiceName: "RegionTargetHttpsProxies", Fields: "name"},
+	Function{Resource: "TargetSslProxy", Name: "TargetSSLProxies", ServiceName: "TargetSslProxies", Fields: "name"},
+	Function{Resource: "TargetTcpProxy", Name: "TargetTCPProxies", ServiceName: "TargetTcpProxies", Fields: "name"},
+	Function{Resource: "TargetInstance", Zone: true, Fields: "name"},
+	Function{Resource: "TargetGrpcProxy", Name: "TargetGRPCProxies", ServiceName: "TargetGrpcProxies", Fields: "name"},
+	Function{Resource: "UrlMap", Zone: false, Name: "URLMaps", Fields: "name"},
+	Function{Resource: "UrlMap", Region: true, Name: "RegionURLMaps", ServiceName: "RegionUrlMaps", Fields: "name"},
 }
 
 func main() {
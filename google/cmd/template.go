@@ -1,7 +1,9 @@
 package main
 
 import (
+	"fmt"
 	"io"
+	"strings"
 	"text/template"
 
 	"github.com/pkg/errors"
@@ -14,6 +16,7 @@ const (
 	// Code generated by 'go generate'; DO NOT EDIT
 	import (
 		"context"
+		"sync"
 
 		"github.com/pkg/errors"
 
@@ -26,38 +29,68 @@ const (
 	// functionTmpl it's the implementation of a reader function
 	functionTmpl = `
 	// List{{ .Name }} returns a list of {{ .Name }} within a project {{ if .Zone }}and a zone {{ end }}
+	// The result is cached so the same call is only requested once
 	func (r *GCPReader) List{{ .Name}}(ctx context.Context{{ if not .NoFilter }}, filter string {{ end }}) ({{ if .Zone }}map[string]{{end}}[]{{ .API }}.{{ .Resource }}, error) {
+		list, err := r.lists.do({{ if .NoFilter }}"List{{ .Name }}"{{ else }}"List{{ .Name }}/"+filter{{ end }}, func() (interface{}, error) {
+			return r.list{{ .Name }}(ctx{{ if not .NoFilter }}, filter{{ end }})
+		})
+		if err != nil {
+			return nil, err
+		}
+		return list.({{ if .Zone }}map[string]{{end}}[]{{ .API }}.{{ .Resource }}), nil
+	}
+
+	func (r *GCPReader) list{{ .Name}}(ctx context.Context{{ if not .NoFilter }}, filter string {{ end }}) ({{ if .Zone }}map[string]{{end}}[]{{ .API }}.{{ .Resource }}, error) {
 		service := {{ .API }}.New{{ .ServiceName}}Service(r.{{ .API }})
 		{{ if .Zone }}
+		var mu sync.Mutex
 		list := make(map[string][]{{ .API }}.{{ .Resource }})
 		zones, err := r.getZones()
 		if err != nil {
 			return nil, errors.Wrap(err, "unable to get zones in region")
 		}
-		for _, zone := range zones {
+		err = parallel(ctx, len(zones), func(ctx context.Context, i int) error {
+		zone := zones[i]
 		{{ end }}
 		resources := make([]{{ .API }}.{{ .Resource }}, 0)
+		// The pages are requested one by one so if one
+		// fails it's retried without losing the previous ones
+		if err := pages(ctx, func(token string) (string, error) {
 		{{ if .Zone }}
-		if err := service.List(r.project, zone).
+		page, err := service.List(r.project, zone).
 		{{ else if .Region }}
-		if err := service.List(r.project, r.region).
+		page, err := service.List(r.project, r.region).
 		{{ else }}
-		if err := service.List(r.project).
+		page, err := service.List(r.project).
 		{{ end }}
 		{{ if not .NoFilter }}
 			Filter(filter).
+		{{ end }}
+		{{ if .Fields }}
+			Fields("nextPageToken", "{{ .FieldMask }}").
 		{{ end }}
 			MaxResults(int64(r.maxResults)).
-			Pages(ctx, func(list *{{ .API }}.{{ .ResourceList }}) error {
-				for _, res := range list.{{ .ItemName }}{
-					resources = append(resources, *res)
-				}
-				return nil
+			PageToken(token).
+			Context(ctx).
+			Do()
+			if err != nil {
+				return "", err
+			}
+			for _, res := range page.{{ .ItemName }}{
+				resources = append(resources, *res)
+			}
+			return page.NextPageToken, nil
 			}); err != nil {
-			return nil, errors.Wrap(err, "unable to list {{ .API }} {{ .Resource }} from google APIs")
+			return {{ if not .Zone }}nil, {{ end }}errors.Wrap(err, "unable to list {{ .API }} {{ .Resource }} from google APIs")
 		}
 		{{ if .Zone }}
+		mu.Lock()
 		list[zone] = resources
+		mu.Unlock()
+		return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 		return list, nil
 		{{ else }}
@@ -131,6 +164,21 @@ type Function struct {
 	// of the list of resources inside the List elements
 	// fetch by TC
 	ItemName string
+
+	// Fields are the fields of each resource requested
+	// on the List (ex: name,labels) so the responses only
+	// have the ones used, as some resources are big.
+	// They are shared by all the resource types using
+	// the function so they have to be extended when one
+	// of them uses more. If empty all the fields are requested
+	Fields string
+}
+
+// FieldMask returns the partial response mask of the
+// Fields, with the JSON name of the ItemName
+// (ex: items(name,labels))
+func (f Function) FieldMask() string {
+	return fmt.Sprintf("%s%s(%s)", strings.ToLower(f.ItemName[:1]), f.ItemName[1:], f.Fields)
 }
 
 // Execute uses the fnTmpl to interpolate f
@@ -0,0 +1,37 @@
+package google
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	googleoauth "golang.org/x/oauth2/google"
+	"google.golang.org/api/option"
+)
+
+// cloudPlatformScope is the scope used to
+// find the Application Default Credentials
+const cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+// credentialsOption returns the option.ClientOption to authenticate
+// the clients from one of the possible sources, in order: the
+// credentials file path, the Options.CredentialsJSON or the
+// Application Default Credentials
+func credentialsOption(ctx context.Context, credentials string, opts Options) (option.ClientOption, error) {
+	if credentials != "" && opts.CredentialsJSON != "" {
+		return nil, errors.New("only one of the credentials file or the JSON credentials can be used")
+	}
+
+	if credentials != "" {
+		return option.WithCredentialsFile(credentials), nil
+	}
+
+	if opts.CredentialsJSON != "" {
+		return option.WithCredentialsJSON([]byte(opts.CredentialsJSON)), nil
+	}
+
+	creds, err := googleoauth.FindDefaultCredentials(ctx, cloudPlatformScope)
+	if err != nil {
+		return nil, errors.Wrap(err, "no credentials available: use a credentials file, the JSON credentials or configure the Application Default Credentials")
+	}
+	return option.WithCredentials(creds), nil
+}
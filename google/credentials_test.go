@@ -0,0 +1,34 @@
+package google
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredentialsOption(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("File", func(t *testing.T) {
+		co, err := credentialsOption(ctx, "credentials.json", Options{})
+		require.NoError(t, err)
+		assert.NotNil(t, co)
+	})
+	t.Run("JSON", func(t *testing.T) {
+		co, err := credentialsOption(ctx, "", Options{CredentialsJSON: `{"type": "service_account"}`})
+		require.NoError(t, err)
+		assert.NotNil(t, co)
+	})
+	t.Run("ErrFileAndJSON", func(t *testing.T) {
+		_, err := credentialsOption(ctx, "credentials.json", Options{CredentialsJSON: `{"type": "service_account"}`})
+		assert.Error(t, err)
+	})
+	t.Run("ErrNoADC", func(t *testing.T) {
+		t.Setenv("GOOGLE_APPLICATION_CREDENTIALS", "/potato/credentials.json")
+
+		_, err := credentialsOption(ctx, "", Options{})
+		assert.Error(t, err)
+	})
+}
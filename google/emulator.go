@@ -0,0 +1,96 @@
+package google
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+
+	tfgoogle "github.com/hashicorp/terraform-provider-google/google"
+	"github.com/pkg/errors"
+)
+
+// emulatorToken is the access token used by the TF provider when
+// only the emulators are used, as they do not check it
+const emulatorToken = "emulator"
+
+// emulatorEnvs are the envs with the host of the emulator of
+// each API, as used by the Google SDKs. The Bigtable emulator
+// only serves the gRPC APIs, and not the REST bigtableadmin
+// used by the GCPReader, and Firestore is not imported so
+// their envs are not used
+var emulatorEnvs = map[string]string{
+	"pubsub":  "PUBSUB_EMULATOR_HOST",
+	"storage": "STORAGE_EMULATOR_HOST",
+}
+
+// emulators returns the hosts of the emulators of the APIs, the
+// Options.Emulators or else the ones of the emulatorEnvs
+func emulators(opts Options) map[string]string {
+	emus := make(map[string]string)
+	for api, env := range emulatorEnvs {
+		if h := os.Getenv(env); h != "" {
+			emus[api] = h
+		}
+	}
+	for api, h := range opts.Emulators {
+		emus[api] = h
+	}
+	return emus
+}
+
+// validateEmulators checks that all the
+// hosts of the emulators are HOST:PORT
+func validateEmulators(emulators map[string]string) error {
+	for api, h := range emulators {
+		host, port, err := net.SplitHostPort(h)
+		if err != nil || host == "" || port == "" {
+			return errors.Errorf("invalid emulator host %q for the API %q, the expected format is 'HOST:PORT'", h, api)
+		}
+	}
+	return nil
+}
+
+// onlyEmulators checks if the requests can not be authenticated
+// as there are emulators but no credentials, so only the
+// resources of the emulated APIs can be imported
+func onlyEmulators(credentials string, opts Options) bool {
+	return credentials == "" && opts.CredentialsJSON == "" && opts.HTTPClient == nil && len(emulators(opts)) > 0
+}
+
+// emulatorEndpoint returns the basePath on the emulator
+// host, which keeps the path of the API and uses HTTP
+func emulatorEndpoint(host, basePath string) string {
+	bp, err := url.Parse(basePath)
+	if err != nil {
+		return basePath
+	}
+	bp.Scheme = "http"
+	bp.Host = host
+	return bp.String()
+}
+
+// emulatorClient returns the HTTP client used to request
+// the emulators, which are not authenticated
+func emulatorClient(opts Options) *http.Client {
+	var t http.RoundTripper = http.DefaultTransport
+	if opts.LogRequests {
+		t = &loggingTransport{next: t}
+	}
+	return &http.Client{Transport: t}
+}
+
+// configureTFEmulators applies the emulators to the base paths
+// of the cfg so the TF provider reads from them too
+func configureTFEmulators(cfg *tfgoogle.Config, emulators map[string]string) {
+	v := reflect.ValueOf(cfg).Elem()
+	for api, h := range emulators {
+		f, ok := tfBasePaths[api]
+		if !ok {
+			continue
+		}
+		bp := v.FieldByName(f)
+		bp.SetString(emulatorEndpoint(h, bp.String()))
+	}
+}
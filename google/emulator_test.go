@@ -0,0 +1,84 @@
+package google
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tfgoogle "github.com/hashicorp/terraform-provider-google/google"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/filter"
+)
+
+func TestEmulators(t *testing.T) {
+	t.Setenv("PUBSUB_EMULATOR_HOST", "localhost:8085")
+	t.Setenv("STORAGE_EMULATOR_HOST", "localhost:4443")
+
+	assert.Equal(t, map[string]string{
+		"pubsub":  "localhost:8085",
+		"storage": "localhost:4443",
+	}, emulators(Options{}))
+
+	assert.Equal(t, map[string]string{
+		"pubsub":  "localhost:8085",
+		"storage": "gcs:9000",
+		"dns":     "localhost:9053",
+	}, emulators(Options{Emulators: map[string]string{"storage": "gcs:9000", "dns": "localhost:9053"}}))
+}
+
+func TestValidateEmulators(t *testing.T) {
+	assert.NoError(t, validateEmulators(map[string]string{"pubsub": "localhost:8085", "storage": "[::1]:4443"}))
+	assert.Error(t, validateEmulators(map[string]string{"pubsub": "localhost"}))
+	assert.Error(t, validateEmulators(map[string]string{"pubsub": "http://localhost:8085"}))
+	assert.Error(t, validateEmulators(map[string]string{"pubsub": ":8085"}))
+}
+
+func TestConfigureTFEmulators(t *testing.T) {
+	cfg := tfgoogle.Config{}
+	tfgoogle.ConfigureBasePaths(&cfg)
+
+	configureTFEmulators(&cfg, map[string]string{
+		"pubsub":  "localhost:8085",
+		"storage": "localhost:4443",
+	})
+
+	assert.Equal(t, "http://localhost:8085/v1/", cfg.PubsubBasePath)
+	assert.Equal(t, "http://localhost:4443/storage/v1/", cfg.StorageBasePath)
+	assert.Equal(t, "https://compute.googleapis.com/compute/v1/", cfg.ComputeBasePath)
+}
+
+func TestEmulatorProvider(t *testing.T) {
+	ctx := context.Background()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		if r.URL.Path != "/v1/projects/my-project/topics" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"topics": [{"name": "projects/my-project/topics/events"}]}`))
+	}))
+	defer ts.Close()
+
+	host := strings.TrimPrefix(ts.URL, "http://")
+	t.Setenv("PUBSUB_EMULATOR_HOST", host)
+	t.Setenv("STORAGE_EMULATOR_HOST", "")
+
+	// Without credentials only the emulators can be used
+	g, err := newProvider(ctx, 500, "my-project", "europe-west1", "", Options{}, nil)
+	require.NoError(t, err)
+
+	cfg := g.TFClient().(*tfgoogle.Config)
+	assert.Equal(t, emulatorToken, cfg.AccessToken)
+	assert.Equal(t, "http://"+host+"/v1/", cfg.PubsubBasePath)
+
+	rs, err := g.Resources(ctx, PubsubTopicIAMPolicy.String(), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	assert.Equal(t, "projects/my-project/topics/events", rs[0].ID())
+}
@@ -0,0 +1,123 @@
+package google
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+
+	tfgoogle "github.com/hashicorp/terraform-provider-google/google"
+	"github.com/pkg/errors"
+)
+
+const (
+	// globalEndpointKey is the key of the Options.Endpoints
+	// that overrides the endpoint of all the APIs
+	globalEndpointKey = "*"
+
+	// endpointAPIPlaceholder is replaced, on the global endpoint,
+	// by the API name taken from the default host of the API,
+	// ex: 'compute' for 'https://compute.googleapis.com/compute/v1/'
+	endpointAPIPlaceholder = "{api}"
+)
+
+// validateEndpoints checks that all the endpoints are
+// absolute http(s) URLs
+func validateEndpoints(endpoints map[string]string) error {
+	for api, e := range endpoints {
+		u, err := url.Parse(strings.ReplaceAll(e, endpointAPIPlaceholder, "api"))
+		if err != nil {
+			return errors.Wrapf(err, "invalid endpoint %q for the API %q", e, api)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf("invalid endpoint %q for the API %q, it must be an absolute http(s) URL", e, api)
+		}
+	}
+	return nil
+}
+
+// endpoint returns the base path to use for the api, being
+// basePath the default one. The endpoints have to be validated
+// before with validateEndpoints
+func endpoint(endpoints map[string]string, api, basePath string) string {
+	if e, ok := endpoints[api]; ok {
+		if !strings.HasSuffix(e, "/") {
+			e += "/"
+		}
+		return e
+	}
+
+	e, ok := endpoints[globalEndpointKey]
+	if !ok {
+		return basePath
+	}
+
+	bp, err := url.Parse(basePath)
+	if err != nil || bp.Host == "" {
+		return basePath
+	}
+
+	// The global one only changes the scheme and host
+	// so the path of each API is kept
+	name := strings.Split(bp.Host, ".")[0]
+	ge, _ := url.Parse(strings.ReplaceAll(e, endpointAPIPlaceholder, name))
+	bp.Scheme = ge.Scheme
+	bp.Host = ge.Host
+
+	return bp.String()
+}
+
+// tfBasePaths maps the APIs used on the GCPReader to
+// the attribute of the tfgoogle.Config with its base path
+var tfBasePaths = map[string]string{
+	"compute":              "ComputeBasePath",
+	"storage":              "StorageBasePath",
+	"sqladmin":             "SQLBasePath",
+	"dns":                  "DNSBasePath",
+	"iam":                  "IAMBasePath",
+	"cloudscheduler":       "CloudSchedulerBasePath",
+	"cloudtasks":           "CloudTasksBasePath",
+	"file":                 "FilestoreBasePath",
+	"pubsub":               "PubsubBasePath",
+	"logging":              "LoggingBasePath",
+	"monitoring":           "MonitoringBasePath",
+	"dataproc":             "DataprocBasePath",
+	"iap":                  "IapBasePath",
+	"cloudidentity":        "CloudIdentityBasePath",
+	"appengine":            "AppEngineBasePath",
+	"bigtableadmin":        "BigtableAdminBasePath",
+	"cloudbilling":         "CloudBillingBasePath",
+	"workflows":            "WorkflowsBasePath",
+	"osconfig":             "OSConfigBasePath",
+	"storagetransfer":      "StorageTransferBasePath",
+	"accesscontextmanager": "AccessContextManagerBasePath",
+	"composer":             "ComposerBasePath",
+	"binaryauthorization":  "BinaryAuthorizationBasePath",
+	// The TF provider uses it for the tags
+	"cloudresourcemanager": "TagsBasePath",
+}
+
+// configureTFEndpoints applies the endpoints to the base paths of
+// the cfg so the TF provider reads from the same endpoints than the
+// GCPReader. The global endpoint is applied to all of them
+func configureTFEndpoints(cfg *tfgoogle.Config, endpoints map[string]string) {
+	if len(endpoints) == 0 {
+		return
+	}
+
+	apis := make(map[string]string, len(tfBasePaths))
+	for api, f := range tfBasePaths {
+		apis[f] = api
+	}
+
+	v := reflect.ValueOf(cfg).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if f.Type.Kind() != reflect.String || !strings.HasSuffix(f.Name, "BasePath") {
+			continue
+		}
+
+		// The ones not used by the GCPReader have no
+		// API name so only the global endpoint applies
+		v.Field(i).SetString(endpoint(endpoints, apis[f.Name], v.Field(i).String()))
+	}
+}
@@ -0,0 +1,75 @@
+package google
+
+import (
+	"testing"
+
+	tfgoogle "github.com/hashicorp/terraform-provider-google/google"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpoint(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Endpoints map[string]string
+		API       string
+		BasePath  string
+		Expected  string
+	}{
+		{
+			Name:     "Default",
+			API:      "compute",
+			BasePath: "https://compute.googleapis.com/compute/v1/",
+			Expected: "https://compute.googleapis.com/compute/v1/",
+		},
+		{
+			Name:      "API",
+			Endpoints: map[string]string{"compute": "https://compute.p.googleapis.com/compute/v1"},
+			API:       "compute",
+			BasePath:  "https://compute.googleapis.com/compute/v1/",
+			Expected:  "https://compute.p.googleapis.com/compute/v1/",
+		},
+		{
+			Name:      "Global",
+			Endpoints: map[string]string{"*": "https://{api}-vpcsc.p.googleapis.com"},
+			API:       "storage",
+			BasePath:  "https://storage.googleapis.com/storage/v1/",
+			Expected:  "https://storage-vpcsc.p.googleapis.com/storage/v1/",
+		},
+		{
+			Name: "APIOverGlobal",
+			Endpoints: map[string]string{
+				"*":   "https://{api}-vpcsc.p.googleapis.com",
+				"dns": "https://dns.internal/dns/v1/",
+			},
+			API:      "dns",
+			BasePath: "https://dns.googleapis.com/dns/v1/",
+			Expected: "https://dns.internal/dns/v1/",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, endpoint(tt.Endpoints, tt.API, tt.BasePath))
+		})
+	}
+}
+
+func TestValidateEndpoints(t *testing.T) {
+	assert.NoError(t, validateEndpoints(map[string]string{"*": "https://{api}.p.googleapis.com"}))
+	assert.Error(t, validateEndpoints(map[string]string{"compute": "compute.p.googleapis.com"}))
+	assert.Error(t, validateEndpoints(map[string]string{"compute": "ftp://compute.p.googleapis.com"}))
+	assert.Error(t, validateEndpoints(map[string]string{"compute": "https://%zz"}))
+}
+
+func TestConfigureTFEndpoints(t *testing.T) {
+	cfg := tfgoogle.Config{}
+	tfgoogle.ConfigureBasePaths(&cfg)
+
+	configureTFEndpoints(&cfg, map[string]string{
+		"*":       "https://{api}.p.googleapis.com",
+		"compute": "https://compute.internal/compute/v1/",
+	})
+
+	assert.Equal(t, "https://compute.internal/compute/v1/", cfg.ComputeBasePath)
+	assert.Equal(t, "https://storage.p.googleapis.com/storage/v1/", cfg.StorageBasePath)
+	assert.Equal(t, "https://pubsub.p.googleapis.com/v1/", cfg.PubsubBasePath)
+}
@@ -0,0 +1,73 @@
+package google
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// enrichFn post-processes the data of a Resource
+// after its state has been read and before its
+// HCL is generated (ex: normalize a value)
+type enrichFn func(d *schema.ResourceData) error
+
+// enrichers are the enrichFn of each ResourceType,
+// the ResourceTypes without one are left as read
+var enrichers = map[ResourceType]enrichFn{
+	ComputeInstance: enrichComputeInstance,
+}
+
+// enrichedResource is a provider.Resource that
+// calls the enrich after reading it
+type enrichedResource struct {
+	provider.Resource
+
+	enrich enrichFn
+}
+
+// Read reads the Resource and then enriches its data
+func (r *enrichedResource) Read(f *filter.Filter) error {
+	if err := r.Resource.Read(f); err != nil {
+		return err
+	}
+	if err := r.enrich(r.Data()); err != nil {
+		return errors.Wrapf(err, "unable to enrich the resource %q with ID %q", r.Type(), r.ID())
+	}
+	return nil
+}
+
+// enrichComputeInstance removes the host of the API from the
+// boot disk image, which is set as a self link, so it does not
+// depend on the endpoint used to import it
+func enrichComputeInstance(d *schema.ResourceData) error {
+	disks, ok := d.Get("boot_disk").([]interface{})
+	if !ok {
+		return nil
+	}
+	for _, disk := range disks {
+		disk, ok := disk.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		params, ok := disk["initialize_params"].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, p := range params {
+			p, ok := p.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if image, ok := p["image"].(string); ok {
+				if i := strings.Index(image, "/projects/"); i != -1 {
+					p["image"] = image[i+1:]
+				}
+			}
+		}
+	}
+	return d.Set("boot_disk", disks)
+}
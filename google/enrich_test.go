@@ -0,0 +1,132 @@
+package google
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/cycloidio/mxwriter"
+	"github.com/golang/mock/gomock"
+	hcl2 "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfgoogle "github.com/hashicorp/terraform-provider-google/google"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/hcl"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/writer"
+)
+
+func TestEnrichComputeInstance(t *testing.T) {
+	d := tfgoogle.Provider().ResourcesMap[ComputeInstance.String()].Data(nil)
+	err := d.Set("boot_disk", []interface{}{
+		map[string]interface{}{
+			"initialize_params": []interface{}{
+				map[string]interface{}{
+					"image": "https://compute.p.googleapis.com/compute/v1/projects/debian-cloud/global/images/debian-10-buster-v20210316",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	err = enrichComputeInstance(d)
+	require.NoError(t, err)
+	assert.Equal(t, "projects/debian-cloud/global/images/debian-10-buster-v20210316", d.Get("boot_disk.0.initialize_params.0.image"))
+}
+
+func TestEnrichedResource(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewResource(ctrl)
+			f    = &filter.Filter{}
+			d    = tfgoogle.Provider().ResourcesMap[ComputeInstance.String()].Data(nil)
+		)
+		defer ctrl.Finish()
+
+		r.EXPECT().Read(f).Return(nil)
+		r.EXPECT().Data().Return(d)
+
+		er := &enrichedResource{
+			Resource: r,
+			enrich: func(ed *schema.ResourceData) error {
+				return ed.Set("name", "enriched")
+			},
+		}
+
+		err := er.Read(f)
+		require.NoError(t, err)
+		assert.Equal(t, "enriched", d.Get("name"))
+	})
+	t.Run("ErrorRead", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			r    = mock.NewResource(ctrl)
+			f    = &filter.Filter{}
+		)
+		defer ctrl.Finish()
+
+		r.EXPECT().Read(f).Return(errors.New("not found"))
+
+		er := &enrichedResource{
+			Resource: r,
+			enrich: func(ed *schema.ResourceData) error {
+				t.Fatal("the enrich must not be called if the Read fails")
+				return nil
+			},
+		}
+
+		err := er.Read(f)
+		assert.EqualError(t, err, "not found")
+	})
+}
+
+func TestComputeInstanceMetadataHCL(t *testing.T) {
+	var (
+		g = &google{
+			tfGoogleClient: &tfgoogle.Config{Project: "my-project", Region: "europe-west1"},
+			tfProvider:     tfgoogle.Provider(),
+		}
+		metadata = map[string]interface{}{
+			"ssh-keys":       "alice:ssh-rsa AAAA alice\nbob:ssh-rsa BBBB bob",
+			"enable-oslogin": "TRUE",
+		}
+		script = "#!/bin/bash\necho \"${HOME}\" > /tmp/home\n"
+	)
+
+	r := provider.NewResource("my-project/europe-west1-b/vm", ComputeInstance.String(), g)
+	require.NoError(t, r.Data().Set("name", "vm"))
+	require.NoError(t, r.Data().Set("machine_type", "n1-standard-1"))
+	require.NoError(t, r.Data().Set("metadata", metadata))
+	require.NoError(t, r.Data().Set("metadata_startup_script", script))
+	require.NoError(t, enrichComputeInstance(r.Data()))
+
+	mx := mxwriter.NewMux()
+	hw := hcl.NewWriter(mx, g, &writer.Options{})
+	require.NoError(t, r.HCL(hw))
+	require.NoError(t, hw.Sync())
+	b, err := ioutil.ReadAll(mx)
+	require.NoError(t, err)
+
+	// The HCL is parsed back to check the values are the same
+	f, diags := hclsyntax.ParseConfig(b, "hcl.tf", hcl2.InitialPos)
+	require.False(t, diags.HasErrors(), diags.Error())
+	blocks := f.Body.(*hclsyntax.Body).Blocks
+	require.Len(t, blocks, 2)
+	attrs := blocks[1].Body.Attributes
+
+	v, diags := attrs["metadata_startup_script"].Expr.Value(nil)
+	require.False(t, diags.HasErrors(), diags.Error())
+	assert.Equal(t, script, v.AsString())
+
+	v, diags = attrs["metadata"].Expr.Value(nil)
+	require.False(t, diags.HasErrors(), diags.Error())
+	for k, e := range metadata {
+		assert.Equal(t, e, v.GetAttr(k).AsString())
+	}
+}
@@ -0,0 +1,153 @@
+package google
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+
+	"github.com/cycloidio/terracognita/errcode"
+)
+
+// rateLimitReasons are the reasons of the errors returned
+// by the Google APIs when the quota of requests is exceeded
+var rateLimitReasons = map[string]struct{}{
+	"rateLimitExceeded":     struct{}{},
+	"userRateLimitExceeded": struct{}{},
+}
+
+// ListError is returned by the Provider when listing the
+// resources of the ResourceType fails. The Err is the cause,
+// which can be one of the other errors of this package
+// (ex: RateLimitError) wrapping the googleapi.Error
+type ListError struct {
+	ResourceType string
+	Err          error
+}
+
+func (e *ListError) Error() string {
+	return fmt.Sprintf("error while reading from resource %q: %s", e.ResourceType, e.Err)
+}
+
+func (e *ListError) Unwrap() error { return e.Err }
+
+// DisabledAPIError is returned when the API of the
+// ResourceType is not enabled on the project
+type DisabledAPIError struct {
+	ResourceType string
+
+	// API is the name of the API as
+	// given by it (ex: Cloud Filestore)
+	API string
+
+	// Skipped is set when the ResourceType is skipped
+	// so the import continues, which is not the case
+	// with the Options.StrictAPIs. Then the error is
+	// also an errcode.ErrProviderAPI
+	Skipped bool
+
+	Err error
+}
+
+func (e *DisabledAPIError) Error() string {
+	if !e.Skipped {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s: skipping %s because the %s API is disabled", errcode.ErrProviderAPI, e.ResourceType, e.API)
+}
+
+func (e *DisabledAPIError) Unwrap() error { return e.Err }
+
+// Is makes the skipped ones an errcode.ErrProviderAPI
+func (e *DisabledAPIError) Is(target error) bool {
+	return e.Skipped && target == errcode.ErrProviderAPI
+}
+
+// PermissionError is returned when the credentials do not
+// have the permissions needed to list the ResourceType
+type PermissionError struct {
+	ResourceType string
+
+	// On is where the permissions are missing
+	// (ex: the organization 123), empty if unknown
+	On string
+
+	// Skipped is set when the ResourceType is skipped
+	// so the import continues, as the ones living on the
+	// organization. Then the error is also an errcode.ErrProviderAPI
+	Skipped bool
+
+	Err error
+}
+
+func (e *PermissionError) Error() string {
+	if !e.Skipped {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s: skipping %s because of missing permissions on %s", errcode.ErrProviderAPI, e.ResourceType, e.On)
+}
+
+func (e *PermissionError) Unwrap() error { return e.Err }
+
+// Is makes the skipped ones an errcode.ErrProviderAPI
+func (e *PermissionError) Is(target error) bool {
+	return e.Skipped && target == errcode.ErrProviderAPI
+}
+
+// RateLimitError is returned when the quota of requests to
+// the Google APIs is still exceeded after retrying them
+type RateLimitError struct {
+	Err error
+}
+
+func (e *RateLimitError) Error() string { return e.Err.Error() }
+
+func (e *RateLimitError) Unwrap() error { return e.Err }
+
+// listError returns the err of listing the resources of
+// the type t as one of the errors of this package
+func (g *google) listError(t string, err error) error {
+	// The ones already typed by the resource
+	// functions are skipped on purpose
+	var perr *PermissionError
+	if errors.As(err, &perr) && perr.Skipped {
+		return err
+	}
+
+	if api, ok := disabledAPI(err); ok {
+		derr := &DisabledAPIError{ResourceType: t, API: api, Skipped: !g.options.StrictAPIs, Err: err}
+		if derr.Skipped {
+			return derr
+		}
+		return &ListError{ResourceType: t, Err: derr}
+	}
+	if rateLimited(err) {
+		return &ListError{ResourceType: t, Err: &RateLimitError{Err: err}}
+	}
+	if permissionDenied(err) {
+		return &ListError{ResourceType: t, Err: &PermissionError{ResourceType: t, Err: err}}
+	}
+	return &ListError{ResourceType: t, Err: err}
+}
+
+// rateLimited checks if the err is because the
+// quota of requests to the API was exceeded
+func rateLimited(err error) bool {
+	var gerr *googleapi.Error
+	if !errors.As(err, &gerr) {
+		return false
+	}
+	if gerr.Code == http.StatusTooManyRequests {
+		return true
+	}
+	if gerr.Code != http.StatusForbidden {
+		return false
+	}
+	for _, e := range gerr.Errors {
+		if _, ok := rateLimitReasons[e.Reason]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package google
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/googleapi"
+
+	"github.com/cycloidio/terracognita/errcode"
+)
+
+func TestListError(t *testing.T) {
+	var (
+		rt       = FilestoreInstance.String()
+		disabled = errors.Wrap(&googleapi.Error{
+			Code: http.StatusForbidden,
+			Errors: []googleapi.ErrorItem{
+				{
+					Reason:  "accessNotConfigured",
+					Message: "Cloud Filestore API has not been used in project 42 before or it is disabled.",
+				},
+			},
+		}, "unable to list filestore instances from reader")
+		denied      = errors.Wrap(&googleapi.Error{Code: http.StatusForbidden, Message: "denied"}, "unable to list filestore instances from reader")
+		rateLimited = errors.Wrap(&googleapi.Error{Code: http.StatusForbidden, Message: "quota", Errors: []googleapi.ErrorItem{{Reason: "rateLimitExceeded"}}}, "unable to list filestore instances from reader")
+		tooMany     = errors.Wrap(&googleapi.Error{Code: http.StatusTooManyRequests, Message: "quota"}, "unable to list filestore instances from reader")
+		other       = errors.New("boom")
+	)
+
+	t.Run("DisabledAPI", func(t *testing.T) {
+		g := &google{}
+		err := g.listError(rt, disabled)
+
+		var derr *DisabledAPIError
+		require.True(t, errors.As(err, &derr))
+		assert.Equal(t, "Cloud Filestore", derr.API)
+		assert.Equal(t, rt, derr.ResourceType)
+		assert.True(t, errors.Is(err, errcode.ErrProviderAPI))
+		assert.EqualError(t, err, "error while requesting the provider APIs: skipping google_filestore_instance because the Cloud Filestore API is disabled")
+
+		var gerr *googleapi.Error
+		assert.True(t, errors.As(err, &gerr))
+	})
+
+	t.Run("DisabledAPIStrict", func(t *testing.T) {
+		g := &google{options: Options{StrictAPIs: true}}
+		err := g.listError(rt, disabled)
+
+		var (
+			lerr *ListError
+			derr *DisabledAPIError
+		)
+		require.True(t, errors.As(err, &lerr))
+		require.True(t, errors.As(err, &derr))
+		assert.False(t, derr.Skipped)
+		assert.False(t, errors.Is(err, errcode.ErrProviderAPI))
+		assert.Equal(t, `error while reading from resource "google_filestore_instance": `+disabled.Error(), err.Error())
+	})
+
+	t.Run("Permission", func(t *testing.T) {
+		g := &google{}
+		err := g.listError(rt, denied)
+
+		var perr *PermissionError
+		require.True(t, errors.As(err, &perr))
+		assert.False(t, errors.Is(err, errcode.ErrProviderAPI))
+		assert.Equal(t, `error while reading from resource "google_filestore_instance": `+denied.Error(), err.Error())
+	})
+
+	t.Run("PermissionSkipped", func(t *testing.T) {
+		g := &google{}
+		skipped := &PermissionError{ResourceType: rt, On: "the organization 123", Skipped: true, Err: denied}
+		err := g.listError(rt, skipped)
+
+		var lerr *ListError
+		assert.False(t, errors.As(err, &lerr))
+		assert.True(t, errors.Is(err, errcode.ErrProviderAPI))
+		assert.EqualError(t, err, "error while requesting the provider APIs: skipping google_filestore_instance because of missing permissions on the organization 123")
+	})
+
+	t.Run("RateLimit", func(t *testing.T) {
+		g := &google{}
+		for _, cause := range []error{rateLimited, tooMany} {
+			err := g.listError(rt, cause)
+
+			var (
+				rerr *RateLimitError
+				perr *PermissionError
+			)
+			assert.True(t, errors.As(err, &rerr))
+			assert.False(t, errors.As(err, &perr))
+		}
+	})
+
+	t.Run("Other", func(t *testing.T) {
+		g := &google{}
+		err := g.listError(rt, other)
+
+		var lerr *ListError
+		require.True(t, errors.As(err, &lerr))
+		assert.Equal(t, rt, lerr.ResourceType)
+		assert.Equal(t, other, errors.Cause(lerr.Err))
+		assert.EqualError(t, err, `error while reading from resource "google_filestore_instance": boom`)
+	})
+}
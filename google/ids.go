@@ -0,0 +1,241 @@
+package google
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cycloidio/terracognita/filter"
+)
+
+// idFormats are the expected formats of the IDs built
+// by the resource functions, where each '{}' is a non
+// empty part of the ID. The resource types using directly
+// the name returned by the API are not validated
+var idFormats = map[ResourceType]*regexp.Regexp{
+	ComputeInstance:                      idFormat("{}/{}/{}"),
+	ComputeNetworkPeering:                idFormat("{}/{}/{}"),
+	ComputeRegionHealthCheck:             idFormat("projects/{}/regions/{}/healthChecks/{}"),
+	ComputeInstanceGroup:                 idFormat("{}/{}/{}"),
+	ComputeInstanceGroupNamedPort:        idFormat("projects/{}/zones/{}/instanceGroups/{}/{}/{}"),
+	ComputeInstanceIAMPolicy:             idFormat("projects/{}/zones/{}/instances/{}"),
+	ComputeInstanceGroupManager:          idFormat("projects/{}/zones/{}/instanceGroupManagers/{}"),
+	ComputeRegionInstanceGroupManager:    idFormat("projects/{}/regions/{}/instanceGroupManagers/{}"),
+	ComputePerInstanceConfig:             idFormat("projects/{}/zones/{}/instanceGroupManagers/{}/{}"),
+	ComputeRegionPerInstanceConfig:       idFormat("projects/{}/regions/{}/instanceGroupManagers/{}/{}"),
+	ComputeRegionSSLCertificate:          idFormat("projects/{}/regions/{}/sslCertificates/{}"),
+	ComputeRegionTargetHTTPProxy:         idFormat("projects/{}/regions/{}/targetHttpProxies/{}"),
+	ComputeRegionTargetHTTPSProxy:        idFormat("projects/{}/regions/{}/targetHttpsProxies/{}"),
+	ComputeRegionURLMap:                  idFormat("projects/{}/regions/{}/urlMaps/{}"),
+	ComputeDisk:                          idFormat("{}/{}"),
+	ComputeResourcePolicy:                idFormat("projects/{}/regions/{}/resourcePolicies/{}"),
+	ComputeDiskResourcePolicyAttachment:  idFormat("{}/{}/{}/{}"),
+	ComputeAttachedDisk:                  idFormat("{}/{}/{}/{}"),
+	ComputeNodeTemplate:                  idFormat("projects/{}/regions/{}/nodeTemplates/{}"),
+	ComputeNodeGroup:                     idFormat("projects/{}/zones/{}/nodeGroups/{}"),
+	ComputeGlobalNetworkEndpointGroup:    idFormat("projects/{}/global/networkEndpointGroups/{}"),
+	ComputeRegionNetworkEndpointGroup:    idFormat("projects/{}/regions/{}/networkEndpointGroups/{}"),
+	ComputeInterconnectAttachment:        idFormat("projects/{}/regions/{}/interconnectAttachments/{}"),
+	ComputeExternalVPNGateway:            idFormat("projects/{}/global/externalVpnGateways/{}"),
+	ComputeReservation:                   idFormat("projects/{}/zones/{}/reservations/{}"),
+	ComputeSSLPolicy:                     idFormat("projects/{}/global/sslPolicies/{}"),
+	ComputeTargetSSLProxy:                idFormat("projects/{}/global/targetSslProxies/{}"),
+	ComputeTargetTCPProxy:                idFormat("projects/{}/global/targetTcpProxies/{}"),
+	ComputeTargetInstance:                idFormat("projects/{}/zones/{}/targetInstances/{}"),
+	ComputeTargetGRPCProxy:               idFormat("projects/{}/global/targetGrpcProxies/{}"),
+	LoggingProjectSink:                   idFormat("projects/{}/sinks/{}"),
+	DataprocCluster:                      idFormat("projects/{}/regions/{}/clusters/{}"),
+	IAPWebIAMPolicy:                      idFormat("projects/{}/iap_web"),
+	IAPWebBackendServiceIAMPolicy:        idFormat("projects/{}/iap_web/compute/services/{}"),
+	AppEngineStandardAppVersion:          idFormat("apps/{}/services/{}/versions/{}"),
+	AppEngineServiceSplitTraffic:         idFormat("apps/{}/services/{}"),
+	BigtableInstance:                     idFormat("{}/{}"),
+	BigtableTable:                        idFormat("{}/{}/{}"),
+	DNSRecordSet:                         idFormat("{}/{}/{}"),
+	FolderIAMPolicy:                      idFormat("folders/{}"),
+	TagsTagKey:                           idFormat("tagKeys/{}"),
+	TagsTagValue:                         idFormat("tagValues/{}"),
+	TagsTagBinding:                       idFormat("tagBindings/{}/tagValues/{}"),
+	ComposerEnvironment:                  idFormat("projects/{}/locations/{}/environments/{}"),
+	AccessContextManagerAccessLevel:      idFormat("accessPolicies/{}/accessLevels/{}"),
+	AccessContextManagerServicePerimeter: idFormat("accessPolicies/{}/servicePerimeters/{}"),
+	BinaryAuthorizationAttestor:          idFormat("projects/{}/attestors/{}"),
+	StorageTransferJob:                   idFormat("{}/{}"),
+}
+
+// idFormat returns the regexp matching the format f
+func idFormat(f string) *regexp.Regexp {
+	parts := strings.Split(regexp.QuoteMeta(f), "\\{\\}")
+	return regexp.MustCompile("^" + strings.Join(parts, "[^/]+") + "$")
+}
+
+// validID checks if the id has the expected format of the rt
+func validID(rt ResourceType, id string) bool {
+	re, ok := idFormats[rt]
+	if !ok {
+		return true
+	}
+	return re.MatchString(id)
+}
+
+// nameIndexes is the position, counting from the end of
+// the ID, of the name used to match the NamePrefix of the
+// filter when it's not the last part. The attachments use
+// the name of the resource they are attached to
+var nameIndexes = map[ResourceType]int{
+	ComputeInstanceGroupNamedPort:       2,
+	ComputeDiskResourcePolicyAttachment: 1,
+	ComputeAttachedDisk:                 1,
+	DNSRecordSet:                        1,
+}
+
+// unnamedResourceTypes are the resource types that have
+// no name of their own (one per project, folder ...) so
+// they are never filtered by the NamePrefix
+var unnamedResourceTypes = map[ResourceType]struct{}{
+	AppEngineApplication:             struct{}{},
+	ComputeProjectMetadata:           struct{}{},
+	ComputeProjectDefaultNetworkTier: struct{}{},
+	BillingAccountIAMPolicy:          struct{}{},
+	BinaryAuthorizationPolicy:        struct{}{},
+	FolderIAMPolicy:                  struct{}{},
+	IAPBrand:                         struct{}{},
+	IAPWebIAMPolicy:                  struct{}{},
+	TagsTagKey:                       struct{}{},
+	TagsTagValue:                     struct{}{},
+	TagsTagBinding:                   struct{}{},
+}
+
+// resourceName returns the name of the resource from its id,
+// false is returned if the rt has no name
+func resourceName(rt ResourceType, id string) (string, bool) {
+	if _, ok := unnamedResourceTypes[rt]; ok {
+		return "", false
+	}
+
+	// The LoggingMetric ID is "project name"
+	sep := "/"
+	if rt == LoggingMetric {
+		sep = " "
+	}
+
+	parts := strings.Split(id, sep)
+	i := len(parts) - 1 - nameIndexes[rt]
+	if i < 0 {
+		return "", false
+	}
+	return parts[i], true
+}
+
+// matchNamePrefix checks if the resource with the id has
+// a name starting with the NamePrefix of the filters
+func matchNamePrefix(filters *filter.Filter, rt ResourceType, id string) bool {
+	if filters == nil || filters.NamePrefix == "" {
+		return true
+	}
+
+	name, ok := resourceName(rt, id)
+	if !ok {
+		return true
+	}
+	return strings.HasPrefix(name, filters.NamePrefix)
+}
+
+// locationRe matches the location (zone, region or
+// location) of the IDs with the API path format
+// (ex: projects/p/zones/europe-west1-b/instances/vm)
+var locationRe = regexp.MustCompile(`(?:^|/)(?:zones|regions|locations)/([^/]+)/`)
+
+// locationIndexes is the position of the zone on the IDs
+// that do not have the API path format
+var locationIndexes = map[ResourceType]int{
+	ComputeInstance:                     1,
+	ComputeInstanceGroup:                1,
+	ComputeDisk:                         0,
+	ComputeDiskResourcePolicyAttachment: 1,
+	ComputeAttachedDisk:                 1,
+}
+
+// regionalResourceTypes are the resource types of the
+// region of the Provider that only have the name as ID
+var regionalResourceTypes = map[ResourceType]struct{}{
+	ComputeForwardingRule: struct{}{},
+}
+
+// resourceLocation returns the zone or region of the
+// resource with the id, the region is the one of the
+// Provider. If it's global or has no location on
+// the id it's empty
+func resourceLocation(rt ResourceType, id, region string) string {
+	if i, ok := locationIndexes[rt]; ok {
+		parts := strings.Split(id, "/")
+		if i < len(parts) {
+			return parts[i]
+		}
+		return ""
+	}
+	if _, ok := regionalResourceTypes[rt]; ok {
+		return region
+	}
+	if m := locationRe.FindStringSubmatch(id); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// existingResources are the IDs of the resources of a type on the
+// existing TFState. The IDs on the state are the ones set by the TF
+// provider (ex: projects/p/global/networks/default) which have not
+// the format of the IDs used to import (ex: default), so they are
+// indexed by their last part to be compared part by part
+type existingResources map[string][][]string
+
+// newExistingResources returns the existingResources of the ids
+func newExistingResources(ids map[string]struct{}) existingResources {
+	er := make(existingResources, len(ids))
+	for id := range ids {
+		parts := strings.Split(id, "/")
+		last := parts[len(parts)-1]
+		er[last] = append(er[last], parts)
+	}
+	return er
+}
+
+// has checks if the resource of the project with the id is one of the
+// existingResources, which is when all the parts of the id are, in the
+// same order, on the ID of the state ending with the same part. The IDs
+// of the state of another project (ex: projects/other/...) never match
+func (er existingResources) has(project, id string) bool {
+	parts := strings.Split(id, "/")
+	for _, sparts := range er[parts[len(parts)-1]] {
+		if stateProject(sparts) != project && stateProject(sparts) != "" {
+			continue
+		}
+		if containsParts(sparts[:len(sparts)-1], parts[:len(parts)-1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// stateProject returns the project of the parts
+// of a state ID, empty if it has none
+func stateProject(parts []string) string {
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == "projects" {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
+
+// containsParts checks if all the parts are on the
+// sparts, in the same order
+func containsParts(sparts, parts []string) bool {
+	i := 0
+	for _, sp := range sparts {
+		if i < len(parts) && sp == parts[i] {
+			i++
+		}
+	}
+	return i == len(parts)
+}
@@ -0,0 +1,116 @@
+package google
+
+import (
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// apiLimits limits the requests done at the same time to each
+// Google API, it's shared by all the readers of a Provider so
+// the limits apply to all the projects imported with it
+type apiLimits struct {
+	// global is the limit of the APIs without their own
+	global int
+	apis   map[string]int
+
+	mu   sync.Mutex
+	sems map[string]chan struct{}
+}
+
+// newAPILimits returns the apiLimits of the Options.MaxConcurrency
+// and Options.APIConcurrency, the global one is maxWorkers if not set
+func newAPILimits(opts Options) *apiLimits {
+	global := opts.MaxConcurrency
+	if global <= 0 {
+		global = maxWorkers
+	}
+	return &apiLimits{
+		global: global,
+		apis:   opts.APIConcurrency,
+		sems:   make(map[string]chan struct{}),
+	}
+}
+
+// sem returns the semaphore of the api
+func (l *apiLimits) sem(api string) chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	s, ok := l.sems[api]
+	if !ok {
+		n, ok := l.apis[api]
+		if !ok {
+			n = l.global
+		}
+		s = make(chan struct{}, n)
+		l.sems[api] = s
+	}
+	return s
+}
+
+// client returns a copy of the c which requests
+// to the api are limited by the limit of it
+func (l *apiLimits) client(c *http.Client, api string) *http.Client {
+	next := c.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	lc := *c
+	lc.Transport = &limitTransport{next: next, sem: l.sem(api)}
+	return &lc
+}
+
+// limitTransport waits for a place on the sem before
+// doing the request, which keeps it until the body of
+// the response is closed
+type limitTransport struct {
+	next http.RoundTripper
+	sem  chan struct{}
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.sem <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	var once sync.Once
+	release := func() { once.Do(func() { <-t.sem }) }
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	res.Body = &releaseBody{ReadCloser: res.Body, release: release}
+	return res, nil
+}
+
+// releaseBody calls the release when it's closed
+type releaseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
+
+// validateConcurrency checks that the limits
+// of concurrency allow at least one request
+func validateConcurrency(global int, apis map[string]int) error {
+	if global < 0 {
+		return errors.Errorf("invalid max concurrency %d, it must be positive or 0 for the default", global)
+	}
+	for api, n := range apis {
+		if n < 1 {
+			return errors.Errorf("invalid concurrency %d for the API %q, it must be at least 1", n, api)
+		}
+	}
+	return nil
+}
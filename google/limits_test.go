@@ -0,0 +1,92 @@
+package google
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPILimits(t *testing.T) {
+	var current, max int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&current, 1)
+		defer atomic.AddInt32(&current, -1)
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	do := func(t *testing.T, c *http.Client, n int) {
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				res, err := c.Get(ts.URL)
+				if !assert.NoError(t, err) {
+					return
+				}
+				ioutil.ReadAll(res.Body)
+				res.Body.Close()
+			}()
+		}
+		wg.Wait()
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		atomic.StoreInt32(&max, 0)
+		l := newAPILimits(Options{})
+		assert.Equal(t, maxWorkers, cap(l.sem("compute")))
+
+		do(t, l.client(ts.Client(), "compute"), 3*maxWorkers)
+		assert.LessOrEqual(t, atomic.LoadInt32(&max), int32(maxWorkers))
+	})
+
+	t.Run("PerAPI", func(t *testing.T) {
+		atomic.StoreInt32(&max, 0)
+		l := newAPILimits(Options{MaxConcurrency: 4, APIConcurrency: map[string]int{"dns": 1}})
+		assert.Equal(t, 4, cap(l.sem("compute")))
+		assert.Equal(t, 1, cap(l.sem("dns")))
+
+		do(t, l.client(ts.Client(), "dns"), 5)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&max))
+	})
+
+	t.Run("SharedByClients", func(t *testing.T) {
+		atomic.StoreInt32(&max, 0)
+		l := newAPILimits(Options{MaxConcurrency: 2})
+
+		// As the readers of each project
+		// have their own client
+		var wg sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				do(t, l.client(ts.Client(), "compute"), 4)
+			}()
+		}
+		wg.Wait()
+		assert.LessOrEqual(t, atomic.LoadInt32(&max), int32(2))
+	})
+}
+
+func TestValidateConcurrency(t *testing.T) {
+	require.NoError(t, validateConcurrency(0, nil))
+	require.NoError(t, validateConcurrency(16, map[string]int{"compute": 4}))
+	assert.Error(t, validateConcurrency(-1, nil))
+	assert.Error(t, validateConcurrency(8, map[string]int{"compute": 0}))
+}
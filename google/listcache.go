@@ -0,0 +1,51 @@
+package google
+
+import "sync"
+
+// listCache memoizes the results of the List calls by key so
+// the same call done by different resource types, even at the
+// same time, is only requested once. The errors are not cached
+// so a failed call is requested again the next time
+type listCache struct {
+	mu    sync.Mutex
+	calls map[string]*listCall
+}
+
+// listCall is a List call done or in progress
+type listCall struct {
+	done chan struct{}
+	list interface{}
+	err  error
+}
+
+// newListCache returns an empty listCache
+func newListCache() *listCache {
+	return &listCache{
+		calls: make(map[string]*listCall),
+	}
+}
+
+// do returns the cached result of the key, or calls fn
+// and caches its result. If the key is being called it
+// waits for it to finish
+func (c *listCache) do(key string, fn func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	if call, ok := c.calls[key]; ok {
+		c.mu.Unlock()
+		<-call.done
+		return call.list, call.err
+	}
+	call := &listCall{done: make(chan struct{})}
+	c.calls[key] = call
+	c.mu.Unlock()
+
+	call.list, call.err = fn()
+	if call.err != nil {
+		c.mu.Lock()
+		delete(c.calls, key)
+		c.mu.Unlock()
+	}
+	close(call.done)
+
+	return call.list, call.err
+}
@@ -0,0 +1,73 @@
+package google
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCache(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var (
+			c     = newListCache()
+			calls int32
+			wg    sync.WaitGroup
+		)
+
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				list, err := c.do("ListBuckets", func() (interface{}, error) {
+					atomic.AddInt32(&calls, 1)
+					return []string{"bucket"}, nil
+				})
+				require.NoError(t, err)
+				assert.Equal(t, []string{"bucket"}, list)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(1), calls)
+	})
+	t.Run("DifferentKeys", func(t *testing.T) {
+		var (
+			c     = newListCache()
+			calls int
+		)
+		fn := func() (interface{}, error) {
+			calls++
+			return nil, nil
+		}
+
+		c.do("ListInstances/", fn)
+		c.do("ListInstances/(labels.env=\"prod\") ", fn)
+
+		assert.Equal(t, 2, calls)
+	})
+	t.Run("ErrorNotCached", func(t *testing.T) {
+		var (
+			c     = newListCache()
+			calls int
+		)
+		fn := func() (interface{}, error) {
+			calls++
+			if calls == 1 {
+				return nil, errors.New("failed")
+			}
+			return []string{"bucket"}, nil
+		}
+
+		_, err := c.do("ListBuckets", fn)
+		assert.EqualError(t, err, "failed")
+
+		list, err := c.do("ListBuckets", fn)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"bucket"}, list)
+		assert.Equal(t, 2, calls)
+	})
+}
@@ -0,0 +1,301 @@
+package google
+
+import (
+	"io"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/tag"
+)
+
+// Options are the optional configurations that
+// can be given to the Google Provider
+type Options struct {
+	// ResourceFilter is called with each Resource listed
+	// by the Provider before its state is fetched. If it
+	// returns false the Resource is dropped, which is cheaper
+	// than filtering after the state has been read.
+	// The Resources only have the ID and the attributes
+	// set from the List call on their Data.
+	ResourceFilter func(provider.Resource) bool
+
+	// MandatoryLabels are the labels the resources always have
+	// to have to be imported, ANDed with the filter.Filter Tags
+	// so they are enforced whatever filters are given. Only the
+	// resource types filtered by labels use them
+	MandatoryLabels []tag.Tag
+
+	// Endpoints overrides the base URL of the Google APIs, which
+	// is needed to read from a VPC Service Controls perimeter
+	// using the restricted endpoints (*.p.googleapis.com).
+	// The key is the API name (ex: compute, storage, sqladmin)
+	// and the value the full base URL to use. The key "*" applies
+	// to all the APIs without their own value and only changes
+	// the scheme and host, keeping the path of each API. On it
+	// "{api}" is replaced by the API name taken from its default
+	// host (ex: https://{api}-myendpoint.p.googleapis.com)
+	Endpoints map[string]string
+
+	// Emulators are the hosts (HOST:PORT) of the emulators of the
+	// Google APIs, indexed by the API name as on the Endpoints
+	// (ex: pubsub), to import from them on local tests. The
+	// PUBSUB_EMULATOR_HOST and STORAGE_EMULATOR_HOST envs are used
+	// for the APIs without one. The requests to the emulators use
+	// HTTP and are not authenticated, and if no credentials are
+	// given none of the requests are, so only the resources of
+	// the emulated APIs can be imported
+	Emulators map[string]string
+
+	// MaxResourcesPerType caps the number of Resources returned
+	// for each resource type, it's meant to sample a project
+	// while exploring it so the imports are faster. When
+	// applied, the truncation is logged.
+	// The default 0 means unlimited.
+	MaxResourcesPerType int
+
+	// ResourcesThreshold is a guardrail on the number of
+	// Resources listed of each resource type, checked right
+	// after listing them. When a type has more the import
+	// fails with errcode.ErrProviderResourcesThreshold so
+	// the filters can be narrowed, unless ResourcesThresholdWarn
+	// is set. The default 0 means unlimited
+	ResourcesThreshold int
+
+	// ResourcesThresholdWarn only warns about the resource
+	// types over the ResourcesThreshold, on the Summary,
+	// and imports them instead of failing
+	ResourcesThresholdWarn bool
+
+	// RawFilters are filter expressions passed as they are to
+	// the List call of the resource type, on the provider-native
+	// syntax (ex: 'status = RUNNING' for the Compute API).
+	// The key is the resource type (ex: google_compute_instance)
+	// and the value the expression, which is ANDed with the
+	// generated labels filter.
+	// Only the resource types which List call accepts a filter
+	// are supported
+	RawFilters map[string]string
+
+	// ExistingState is an existing TFState, the resources already
+	// present on it are skipped so only the new ones are imported.
+	// They are matched by type and import ID with the 'id'
+	// attribute of the TFState resources
+	ExistingState io.Reader
+
+	// Experimental enables the import of the resource types
+	// which import is not stable yet (see IsExperimental),
+	// by default they are not imported
+	Experimental bool
+
+	// StrictAPIs makes the import fail when one of the
+	// Google APIs is disabled on the project. By default
+	// the resource types of a disabled API are skipped
+	StrictAPIs bool
+
+	// Organization is the ID of the organization used
+	// to discover the resources that live on it instead
+	// of on the project (ex: organization IAM custom roles,
+	// tag keys and values).
+	// If empty those resource types are not imported
+	Organization string
+
+	// Folder is the ID of the folder used to discover
+	// the resources that live on it instead of on the
+	// project (ex: folder IAM policy).
+	// If empty those resource types are not imported
+	Folder string
+
+	// BillingAccount is the ID of the billing account
+	// (ex: 012345-6789AB-CDEF01) used to discover the
+	// resources that live on it (ex: billing account IAM policy).
+	// If empty those resource types are not imported
+	BillingAccount string
+
+	// AccessPolicy is the ID of the Access Context Manager
+	// policy used to discover the access levels and service
+	// perimeters. If empty the policies of the Organization
+	// are used and if there is none those resource types
+	// are not imported
+	AccessPolicy string
+
+	// ProjectsInclude and ProjectsExclude are glob patterns
+	// (ex: prod-*) of the IDs of the projects imported by the
+	// NewDiscoveryProvider. A project is imported if it matches
+	// one of the ProjectsInclude, or there are none, and none
+	// of the ProjectsExclude. They are not used by NewProvider
+	ProjectsInclude []string
+	ProjectsExclude []string
+
+	// ProviderVersion is the major version of the Google TF
+	// provider for which the resources are written, so the
+	// types renamed on it are written with their new name.
+	// The default 0 means the version used to read them
+	ProviderVersion int
+
+	// Customer is the Cloud Identity customer ID (ex: C0123abcd)
+	// used to discover the Cloud Identity groups and memberships.
+	// If empty those resource types are not imported
+	Customer string
+
+	// CredentialsJSON is the content of the JSON credentials
+	// to use instead of a credentials file path. If none of
+	// them is set the Application Default Credentials are used
+	CredentialsJSON string
+
+	// UserAgent is the User-Agent of the requests done
+	// to the Google APIs. If empty 'terracognita' is used
+	UserAgent string
+
+	// Zones are the zones of the region from which the zonal
+	// resources (ex: instances, disks, instance groups) are
+	// imported. When set the zones of the region are not
+	// discovered from the API so the other zones are skipped.
+	// They have to be on the region of the Provider
+	Zones []string
+
+	// LogRequests logs each request done to the Google APIs
+	// with the status and latency of the response, the
+	// sensitive values like the Authorization are redacted
+	LogRequests bool
+
+	// Timings reports at the end of the import how long
+	// the listing of each resource type took, from the
+	// slowest to the fastest
+	Timings bool
+
+	// Proxy is the URL of the HTTP(S) or SOCKS5 proxy through
+	// which the Google APIs are requested (ex: http://proxy:3128).
+	// It has to be reachable. The Terraform provider reading the
+	// state of the resources uses the HTTPS_PROXY env instead
+	Proxy string
+
+	// CAFile is the path of a PEM file with the certificates
+	// of the CAs trusted, on top of the system ones, when
+	// requesting the Google APIs (ex: the one of the Proxy)
+	CAFile string
+
+	// MaxConcurrency is the max number of requests done at
+	// the same time to each Google API, shared by all the
+	// projects of the Provider. The default 0 means 8
+	MaxConcurrency int
+
+	// APIConcurrency overrides the MaxConcurrency of some APIs,
+	// so the ones with a strict quota can have a lower limit and
+	// the generous ones a higher. The key is the API name as on
+	// the Endpoints (ex: compute, storage, dns)
+	APIConcurrency map[string]int
+
+	// limits are the apiLimits of the MaxConcurrency and
+	// APIConcurrency shared by all the projects of the Provider
+	limits *apiLimits
+
+	// HTTPClient is used to request the Google APIs instead of
+	// the one built from the credentials, so the caller has full
+	// control of the transport (ex: tracing, metrics, retries).
+	// It has to authenticate the requests itself and it can not
+	// be used with the Proxy or the CAFile. The UserAgent and
+	// LogRequests are not applied to it. The Terraform provider
+	// reading the state of the resources keeps its own client
+	HTTPClient *http.Client
+}
+
+// validateHTTPClient checks that the HTTPClient, which
+// has its own transport, is not used with the options
+// that configure the transport built otherwise
+func validateHTTPClient(opts Options) error {
+	if opts.HTTPClient == nil {
+		return nil
+	}
+	if opts.Proxy != "" || opts.CAFile != "" {
+		return errors.New("the HTTP client can not be used with the proxy or the CA file, they have to be configured on it")
+	}
+	return nil
+}
+
+// validateRawFilters checks that the RawFilters are not empty
+// and are on resource types that support them
+func validateRawFilters(filters map[string]string) error {
+	for t, f := range filters {
+		rt, err := ResourceTypeString(t)
+		if err != nil {
+			return errors.Wrapf(err, "invalid raw filter resource type %q", t)
+		}
+		if _, ok := rawFilterResourceTypes[rt]; !ok {
+			return errors.Errorf("the resource type %q does not support raw filters", t)
+		}
+		if strings.TrimSpace(f) == "" {
+			return errors.Errorf("the raw filter of the resource type %q is empty", t)
+		}
+	}
+	return nil
+}
+
+var (
+	// labelKeyRe and labelValueRe are the formats of the
+	// keys and values of the labels on the Google APIs
+	labelKeyRe   = regexp.MustCompile(`^[\p{Ll}\p{Lo}][\p{Ll}\p{Lo}\p{N}_-]{0,62}$`)
+	labelValueRe = regexp.MustCompile(`^[\p{Ll}\p{Lo}\p{N}_-]{0,63}$`)
+)
+
+// validateMandatoryLabels checks that the labels
+// have a valid key and value for the Google APIs
+func validateMandatoryLabels(labels []tag.Tag) error {
+	for _, l := range labels {
+		if !labelKeyRe.MatchString(l.Name) {
+			return errors.Errorf("invalid mandatory label key %q, it must start with a lowercase letter and have at most 63 lowercase letters, numbers, _ or -", l.Name)
+		}
+		if !labelValueRe.MatchString(l.Value) {
+			return errors.Errorf("invalid mandatory label value %q of the key %q, it must have at most 63 lowercase letters, numbers, _ or -", l.Value, l.Name)
+		}
+	}
+	return nil
+}
+
+// validateProjectPatterns checks that the
+// patterns have a valid glob syntax
+func validateProjectPatterns(patterns []string) error {
+	for _, p := range patterns {
+		if _, err := path.Match(p, ""); err != nil {
+			return errors.Wrapf(err, "invalid project pattern %q", p)
+		}
+	}
+	return nil
+}
+
+// matchProject checks if the project with the id has
+// to be imported with the ProjectsInclude and ProjectsExclude
+func matchProject(opts Options, id string) bool {
+	included := len(opts.ProjectsInclude) == 0
+	for _, p := range opts.ProjectsInclude {
+		if ok, _ := path.Match(p, id); ok {
+			included = true
+			break
+		}
+	}
+	if !included {
+		return false
+	}
+	for _, p := range opts.ProjectsExclude {
+		if ok, _ := path.Match(p, id); ok {
+			return false
+		}
+	}
+	return true
+}
+
+// validateZones checks that the zones are on the region,
+// the zone names are the region name with a suffix
+// (ex: us-central1-a on us-central1)
+func validateZones(region string, zones []string) error {
+	for _, z := range zones {
+		if !strings.HasPrefix(z, region+"-") || len(z) == len(region)+1 {
+			return errors.Errorf("the zone %q is not on the region %q", z, region)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cycloidio/terracognita/tag"
+)
+
+func TestValidateRawFilters(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Filters map[string]string
+		Err     bool
+	}{
+		{
+			Name: "Empty",
+		},
+		{
+			Name:    "Valid",
+			Filters: map[string]string{"google_compute_instance": "status = RUNNING"},
+		},
+		{
+			Name:    "UnknownType",
+			Filters: map[string]string{"google_compute_potato": "status = RUNNING"},
+			Err:     true,
+		},
+		{
+			Name:    "NotSupportedType",
+			Filters: map[string]string{"google_storage_bucket": "name = potato"},
+			Err:     true,
+		},
+		{
+			Name:    "EmptyFilter",
+			Filters: map[string]string{"google_compute_instance": " "},
+			Err:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			err := validateRawFilters(tt.Filters)
+			if tt.Err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateZones(t *testing.T) {
+	tests := []struct {
+		Name  string
+		Zones []string
+		Err   bool
+	}{
+		{
+			Name: "Empty",
+		},
+		{
+			Name:  "Valid",
+			Zones: []string{"us-central1-a", "us-central1-f"},
+		},
+		{
+			Name:  "OtherRegion",
+			Zones: []string{"us-central1-a", "europe-west1-b"},
+			Err:   true,
+		},
+		{
+			Name:  "Region",
+			Zones: []string{"us-central1-"},
+			Err:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			err := validateZones("us-central1", tt.Zones)
+			if tt.Err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateMandatoryLabels(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Labels []tag.Tag
+		Err    bool
+	}{
+		{
+			Name: "Empty",
+		},
+		{
+			Name:   "Valid",
+			Labels: []tag.Tag{{Name: "environment", Value: "prod"}, {Name: "cost-center", Value: "42_a"}, {Name: "empty"}},
+		},
+		{
+			Name:   "UppercaseKey",
+			Labels: []tag.Tag{{Name: "Environment", Value: "prod"}},
+			Err:    true,
+		},
+		{
+			Name:   "KeyStartingWithNumber",
+			Labels: []tag.Tag{{Name: "1env", Value: "prod"}},
+			Err:    true,
+		},
+		{
+			Name:   "InvalidValue",
+			Labels: []tag.Tag{{Name: "environment", Value: "prod env"}},
+			Err:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			err := validateMandatoryLabels(tt.Labels)
+			if tt.Err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
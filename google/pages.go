@@ -0,0 +1,77 @@
+package google
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+
+	"github.com/cycloidio/terracognita/log"
+)
+
+// pageRetries is the number of times a page is
+// requested again when it fails with a retryable error
+const pageRetries = 3
+
+// pageRetryInterval is the interval to wait before
+// the first retry of a page, it's doubled on each retry
+var pageRetryInterval = time.Second
+
+// pageFn requests the page of the token and returns the
+// token of the next page, which is empty on the last one
+type pageFn func(token string) (string, error)
+
+// pages calls fn for each page starting from the first one.
+// If a page fails with a retryable error it's requested again
+// with the same token, so the pages already read are not lost
+func pages(ctx context.Context, fn pageFn) error {
+	var token string
+	for {
+		next, err := retryPage(ctx, fn, token)
+		if err != nil {
+			return err
+		}
+		if next == "" {
+			return nil
+		}
+		token = next
+	}
+}
+
+// retryPage calls fn with the token, retrying it with
+// exponential backoff while the error is retryable
+func retryPage(ctx context.Context, fn pageFn, token string) (string, error) {
+	interval := pageRetryInterval
+	for i := 0; ; i++ {
+		next, err := fn(token)
+		if err == nil || i == pageRetries || !retryableError(err) {
+			return next, err
+		}
+
+		log.FromContext(ctx).Log("func", "google.retryPage", "msg", "retrying the page", "error", err, "times-left", pageRetries-i)
+
+		select {
+		case <-ctx.Done():
+			return "", errors.WithStack(ctx.Err())
+		case <-time.After(interval):
+		}
+		recordRetry(ctx, interval)
+		interval *= 2
+	}
+}
+
+// retryableError checks if the err is a temporary
+// error of the Google APIs that can be retried
+func retryableError(err error) bool {
+	var gerr *googleapi.Error
+	if !errors.As(err, &gerr) {
+		return false
+	}
+	switch gerr.Code {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package google
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/googleapi"
+)
+
+func TestPages(t *testing.T) {
+	defer func(i time.Duration) { pageRetryInterval = i }(pageRetryInterval)
+	pageRetryInterval = time.Millisecond
+
+	t.Run("Success", func(t *testing.T) {
+		var tokens []string
+		err := pages(context.Background(), func(token string) (string, error) {
+			tokens = append(tokens, token)
+			if token == "2" {
+				return "", nil
+			}
+			if token == "" {
+				return "1", nil
+			}
+			return "2", nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"", "1", "2"}, tokens)
+	})
+	t.Run("ResumeOnRetryableError", func(t *testing.T) {
+		var (
+			tokens []string
+			failed bool
+		)
+		err := pages(context.Background(), func(token string) (string, error) {
+			tokens = append(tokens, token)
+			if token == "1" && !failed {
+				failed = true
+				return "", &googleapi.Error{Code: http.StatusServiceUnavailable}
+			}
+			if token == "" {
+				return "1", nil
+			}
+			return "", nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"", "1", "1"}, tokens)
+	})
+	t.Run("RecordRetries", func(t *testing.T) {
+		rs := newRetryStats()
+		ctx := withRetryStats(context.Background(), rs, "google_compute_instance")
+
+		err := pages(ctx, func(token string) (string, error) {
+			return "", &googleapi.Error{Code: http.StatusTooManyRequests}
+		})
+		require.Error(t, err)
+
+		err = pages(withRetryStats(context.Background(), rs, "google_compute_disk"), func(token string) (string, error) {
+			if token == "" {
+				return "1", nil
+			}
+			if rs.types["google_compute_disk"] == nil {
+				return "", &googleapi.Error{Code: http.StatusServiceUnavailable}
+			}
+			return "", nil
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "Retries of the Google APIs requests (8ms waiting in total):\n"+
+			"  - google_compute_disk: 1 retries, 1ms waiting\n"+
+			"  - google_compute_instance: 3 retries, 7ms waiting\n", rs.summary())
+	})
+	t.Run("ErrorNotRetryable", func(t *testing.T) {
+		var calls int
+		err := pages(context.Background(), func(token string) (string, error) {
+			calls++
+			return "", &googleapi.Error{Code: http.StatusForbidden}
+		})
+		require.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+	t.Run("ErrorMaxRetries", func(t *testing.T) {
+		var calls int
+		err := pages(context.Background(), func(token string) (string, error) {
+			calls++
+			return "", &googleapi.Error{Code: http.StatusTooManyRequests}
+		})
+		require.Error(t, err)
+		assert.Equal(t, pageRetries+1, calls)
+	})
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := pages(ctx, func(token string) (string, error) {
+			return "", &googleapi.Error{Code: http.StatusTooManyRequests}
+		})
+		assert.True(t, errors.Is(err, context.Canceled))
+	})
+}
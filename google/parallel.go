@@ -0,0 +1,66 @@
+package google
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// maxWorkers is the max number of requests done at
+// the same time when iterating over a list of resources
+const maxWorkers = 8
+
+// parallel calls fn for each index from 0 to n with at
+// most maxWorkers at the same time. All the errors are
+// aggregated and if the ctx is done no more calls are made
+func parallel(ctx context.Context, n int, fn func(ctx context.Context, i int) error) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		merr *multierror.Error
+		idxs = make(chan int)
+	)
+
+	workers := maxWorkers
+	if n < workers {
+		workers = n
+	}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range idxs {
+				if err := fn(ctx, i); err != nil {
+					mu.Lock()
+					merr = multierror.Append(merr, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+indexes:
+	for i := 0; i < n; i++ {
+		select {
+		case idxs <- i:
+		case <-ctx.Done():
+			break indexes
+		}
+	}
+	close(idxs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		merr = multierror.Append(merr, err)
+	}
+
+	// To not change the error message when
+	// only one fails we return it as it is
+	if merr != nil && len(merr.Errors) == 1 {
+		return merr.Errors[0]
+	}
+
+	return merr.ErrorOrNil()
+}
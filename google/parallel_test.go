@@ -0,0 +1,77 @@
+package google
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParallel(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var (
+			mu      sync.Mutex
+			called  = make(map[int]struct{})
+			current int32
+			max     int32
+		)
+
+		err := parallel(context.Background(), 50, func(ctx context.Context, i int) error {
+			c := atomic.AddInt32(&current, 1)
+			defer atomic.AddInt32(&current, -1)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if c > max {
+				max = c
+			}
+			called[i] = struct{}{}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Len(t, called, 50)
+		assert.LessOrEqual(t, max, int32(maxWorkers))
+	})
+	t.Run("Errors", func(t *testing.T) {
+		err := parallel(context.Background(), 3, func(ctx context.Context, i int) error {
+			if i == 0 {
+				return nil
+			}
+			return errors.New("failed")
+		})
+		require.Error(t, err)
+
+		var merr *multierror.Error
+		require.True(t, errors.As(err, &merr))
+		assert.Len(t, merr.Errors, 2)
+	})
+	t.Run("Error", func(t *testing.T) {
+		eerr := errors.New("failed")
+		err := parallel(context.Background(), 3, func(ctx context.Context, i int) error {
+			if i == 1 {
+				return eerr
+			}
+			return nil
+		})
+		assert.Equal(t, eerr, err)
+	})
+	t.Run("Canceled", func(t *testing.T) {
+		var (
+			ctx, cancel = context.WithCancel(context.Background())
+			calls       int32
+		)
+		cancel()
+
+		err := parallel(ctx, 100, func(ctx context.Context, i int) error {
+			atomic.AddInt32(&calls, 1)
+			return nil
+		})
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.Less(t, atomic.LoadInt32(&calls), int32(100))
+	})
+}
@@ -0,0 +1,163 @@
+package google
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// maxTestPermissions is the maximum number of permissions
+// that can be tested on a single request
+const maxTestPermissions = 100
+
+// projectPermissions are the permissions on the project needed to
+// list the resources of each ResourceType. The ones missing are
+// the types that live outside of the project (ex: organization,
+// folder) or which access is not managed with IAM (ex: the Cloud
+// Identity groups), so they can not be checked with the project
+var projectPermissions = map[ResourceType][]string{
+	ComputeInstance:                     {"compute.instances.list"},
+	ComputeFirewall:                     {"compute.firewalls.list"},
+	ComputeNetwork:                      {"compute.networks.list"},
+	ComputeNetworkPeering:               {"compute.networks.list"},
+	ComputeHealthCheck:                  {"compute.healthChecks.list"},
+	ComputeRegionHealthCheck:            {"compute.regionHealthChecks.list"},
+	ComputeHTTPHealthCheck:              {"compute.httpHealthChecks.list"},
+	ComputeHTTPSHealthCheck:             {"compute.httpsHealthChecks.list"},
+	ComputeInstanceGroup:                {"compute.instanceGroups.list"},
+	ComputeInstanceGroupNamedPort:       {"compute.instanceGroups.list"},
+	ComputeInstanceIAMPolicy:            {"compute.instances.list", "compute.instances.getIamPolicy"},
+	ComputeInstanceGroupManager:         {"compute.instanceGroupManagers.list"},
+	ComputeRegionInstanceGroupManager:   {"compute.instanceGroupManagers.list"},
+	ComputePerInstanceConfig:            {"compute.instanceGroupManagers.list", "compute.instanceGroupManagers.get"},
+	ComputeRegionPerInstanceConfig:      {"compute.instanceGroupManagers.list", "compute.instanceGroupManagers.get"},
+	ComputeBackendBucket:                {"compute.backendBuckets.list"},
+	ComputeBackendService:               {"compute.backendServices.list"},
+	ComputeSSLCertificate:               {"compute.sslCertificates.list"},
+	ComputeTargetHTTPProxy:              {"compute.targetHttpProxies.list"},
+	ComputeTargetHTTPSProxy:             {"compute.targetHttpsProxies.list"},
+	ComputeURLMap:                       {"compute.urlMaps.list"},
+	ComputeRegionSSLCertificate:         {"compute.regionSslCertificates.list"},
+	ComputeRegionTargetHTTPProxy:        {"compute.regionTargetHttpProxies.list"},
+	ComputeRegionTargetHTTPSProxy:       {"compute.regionTargetHttpsProxies.list"},
+	ComputeRegionURLMap:                 {"compute.regionUrlMaps.list"},
+	ComputeGlobalForwardingRule:         {"compute.globalForwardingRules.list"},
+	ComputeForwardingRule:               {"compute.forwardingRules.list"},
+	ComputeDisk:                         {"compute.disks.list"},
+	ComputeResourcePolicy:               {"compute.resourcePolicies.list"},
+	ComputeDiskResourcePolicyAttachment: {"compute.disks.list"},
+	ComputeAttachedDisk:                 {"compute.instances.list"},
+	ComputeNodeTemplate:                 {"compute.nodeTemplates.list"},
+	ComputeNodeGroup:                    {"compute.nodeGroups.list"},
+	ComputeGlobalNetworkEndpointGroup:   {"compute.globalNetworkEndpointGroups.list"},
+	ComputeRegionNetworkEndpointGroup:   {"compute.regionNetworkEndpointGroups.list"},
+	ComputeInterconnectAttachment:       {"compute.interconnectAttachments.list"},
+	ComputeExternalVPNGateway:           {"compute.externalVpnGateways.list"},
+	ComputeReservation:                  {"compute.reservations.list"},
+	ComputeSSLPolicy:                    {"compute.sslPolicies.list"},
+	ComputeTargetSSLProxy:               {"compute.targetSslProxies.list"},
+	ComputeTargetTCPProxy:               {"compute.targetTcpProxies.list"},
+	ComputeTargetInstance:               {"compute.targetInstances.list"},
+	ComputeTargetGRPCProxy:              {"compute.targetGrpcProxies.list"},
+	ComputeProjectMetadata:              {"compute.projects.get"},
+	ComputeProjectDefaultNetworkTier:    {"compute.projects.get"},
+	CloudSchedulerJob:                   {"cloudscheduler.jobs.list"},
+	CloudTasksQueue:                     {"cloudtasks.queues.list"},
+	WorkflowsWorkflow:                   {"workflows.workflows.list"},
+	EventarcTrigger:                     {"eventarc.triggers.list"},
+	ComposerEnvironment:                 {"composer.environments.list"},
+	OSConfigPatchDeployment:             {"osconfig.patchDeployments.list"},
+	FilestoreInstance:                   {"file.instances.list"},
+	PubsubTopicIAMPolicy:                {"pubsub.topics.list", "pubsub.topics.getIamPolicy"},
+	LoggingProjectSink:                  {"logging.sinks.list"},
+	LoggingMetric:                       {"logging.logMetrics.list"},
+	MonitoringAlertPolicy:               {"monitoring.alertPolicies.list"},
+	MonitoringNotificationChannel:       {"monitoring.notificationChannels.list"},
+	DataprocCluster:                     {"dataproc.clusters.list"},
+	IAPBrand:                            {"clientauthconfig.brands.list"},
+	IAPWebIAMPolicy:                     {"iap.web.getIamPolicy"},
+	IAPWebBackendServiceIAMPolicy:       {"compute.backendServices.list", "iap.webServices.getIamPolicy"},
+	AppEngineApplication:                {"appengine.applications.get"},
+	AppEngineStandardAppVersion:         {"appengine.services.list", "appengine.versions.list"},
+	AppEngineServiceSplitTraffic:        {"appengine.services.list"},
+	BigtableInstance:                    {"bigtable.instances.list"},
+	BigtableTable:                       {"bigtable.instances.list", "bigtable.tables.list"},
+	DNSManagedZone:                      {"dns.managedZones.list"},
+	DNSRecordSet:                        {"dns.managedZones.list", "dns.resourceRecordSets.list"},
+	ProjectIAMCustomRole:                {"iam.roles.list"},
+	TagsTagBinding:                      {"resourcemanager.projects.get", "resourcemanager.hierarchyNodes.listTagBindings"},
+	BinaryAuthorizationPolicy:           {"binaryauthorization.policy.get"},
+	BinaryAuthorizationAttestor:         {"binaryauthorization.attestors.list"},
+	StorageBucket:                       {"storage.buckets.list"},
+	StorageBucketIAMPolicy:              {"storage.buckets.list", "storage.buckets.getIamPolicy"},
+	StorageTransferJob:                  {"storagetransfer.jobs.list"},
+	SQLDatabaseInstance:                 {"cloudsql.instances.list"},
+}
+
+// PermissionIssue is returned by the Preflight for
+// each ResourceType which permissions are missing
+type PermissionIssue struct {
+	ResourceType ResourceType
+
+	// Project is the ID of the project
+	// on which the Permissions are missing
+	Project string
+
+	// Permissions are the ones missing, sorted
+	Permissions []string
+}
+
+// Preflight checks, without listing any resource, that the credentials
+// are valid and have the permissions needed to list the resources of
+// the types on the project. It returns the ResourceTypes which
+// permissions are missing, in the order of the types. The ones that do
+// not live on the project (ex: OrganizationIAMCustomRole) can not be
+// checked so they are never reported
+func (g *google) Preflight(ctx context.Context, types []ResourceType) ([]PermissionIssue, error) {
+	var (
+		permissions = make([]string, 0)
+		seen        = make(map[string]struct{})
+	)
+	for _, t := range types {
+		for _, p := range projectPermissions[t] {
+			if _, ok := seen[p]; ok {
+				continue
+			}
+			seen[p] = struct{}{}
+			permissions = append(permissions, p)
+		}
+	}
+
+	// Even without permissions to check the request is
+	// done so the validity of the credentials is checked
+	granted, err := g.gcpr.TestProjectPermissions(ctx, permissions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to check the permissions on the project %s", g.Project())
+	}
+	grantedSet := make(map[string]struct{}, len(granted))
+	for _, p := range granted {
+		grantedSet[p] = struct{}{}
+	}
+
+	issues := make([]PermissionIssue, 0)
+	for _, t := range types {
+		var missing []string
+		for _, p := range projectPermissions[t] {
+			if _, ok := grantedSet[p]; !ok {
+				missing = append(missing, p)
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		sort.Strings(missing)
+		issues = append(issues, PermissionIssue{
+			ResourceType: t,
+			Project:      g.Project(),
+			Permissions:  missing,
+		})
+	}
+
+	return issues, nil
+}
@@ -0,0 +1,124 @@
+package google
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	tfgoogle "github.com/hashicorp/terraform-provider-google/google"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	crmv1 "google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/option"
+)
+
+func TestPreflight(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		requests int32
+		granted  = map[string]struct{}{
+			"compute.instances.list": struct{}{},
+			"dns.managedZones.list":  struct{}{},
+		}
+	)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/projects/unknown:testIamPermissions" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error": {"code": 403, "message": "The caller does not have permission"}}`))
+			return
+		}
+		if r.URL.Path != "/v1/projects/my-project:testIamPermissions" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		atomic.AddInt32(&requests, 1)
+
+		var req crmv1.TestIamPermissionsRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.LessOrEqual(t, len(req.Permissions), maxTestPermissions)
+
+		var res crmv1.TestIamPermissionsResponse
+		for _, p := range req.Permissions {
+			if _, ok := granted[p]; ok {
+				res.Permissions = append(res.Permissions, p)
+			}
+		}
+		json.NewEncoder(w).Encode(res)
+	}
+	setReader := func(r *GCPReader, opts ...option.ClientOption) (err error) {
+		r.projects, err = crmv1.NewService(ctx, opts...)
+		return err
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		issues, err := newTestGoogle(t, handler, setReader).Preflight(ctx, []ResourceType{ComputeInstance, DNSRecordSet, OrganizationIAMCustomRole, ComputeInstanceIAMPolicy})
+		require.NoError(t, err)
+		assert.Equal(t, []PermissionIssue{
+			{ResourceType: DNSRecordSet, Project: "my-project", Permissions: []string{"dns.resourceRecordSets.list"}},
+			{ResourceType: ComputeInstanceIAMPolicy, Project: "my-project", Permissions: []string{"compute.instances.getIamPolicy"}},
+		}, issues)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("Batches", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		permissions := make([]string, 0, maxTestPermissions+1)
+		for i := 0; i < maxTestPermissions; i++ {
+			permissions = append(permissions, fmt.Sprintf("compute.unknown%d.list", i))
+		}
+		permissions = append(permissions, "dns.managedZones.list")
+
+		got, err := newTestGoogle(t, handler, setReader).gcpr.TestProjectPermissions(ctx, permissions)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dns.managedZones.list"}, got)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("Unchecked", func(t *testing.T) {
+		// The credentials are still checked
+		atomic.StoreInt32(&requests, 0)
+		issues, err := newTestGoogle(t, handler, setReader).Preflight(ctx, []ResourceType{OrganizationIAMCustomRole, FolderIAMPolicy})
+		require.NoError(t, err)
+		assert.Empty(t, issues)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		g := newTestGoogle(t, handler, setReader)
+		g.tfGoogleClient.(*tfgoogle.Config).Project = "unknown"
+		g.gcpr.project = "unknown"
+
+		_, err := g.Preflight(ctx, []ResourceType{ComputeInstance})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to check the permissions on the project unknown")
+	})
+}
+
+func TestProjectPermissions(t *testing.T) {
+	// All the project level types have permissions,
+	// the other ones have to be checked elsewhere
+	unchecked := map[ResourceType]struct{}{
+		CloudIdentityGroup:                   struct{}{},
+		CloudIdentityGroupMembership:         struct{}{},
+		OrganizationIAMCustomRole:            struct{}{},
+		FolderIAMPolicy:                      struct{}{},
+		BillingAccountIAMPolicy:              struct{}{},
+		TagsTagKey:                           struct{}{},
+		TagsTagValue:                         struct{}{},
+		AccessContextManagerAccessLevel:      struct{}{},
+		AccessContextManagerServicePerimeter: struct{}{},
+	}
+	for _, rt := range ResourceTypeValues() {
+		if _, ok := unchecked[rt]; ok {
+			assert.NotContains(t, projectPermissions, rt)
+			continue
+		}
+		assert.NotEmpty(t, projectPermissions[rt], rt.String())
+	}
+}
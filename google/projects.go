@@ -0,0 +1,258 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/log"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// projects is a Provider that imports from all the projects
+// discovered with the credentials, each one with its own google
+// Provider so the resources are read with the Config of their project
+type projects struct {
+	// providers are sorted by project ID
+	providers []*google
+	region    string
+	options   Options
+}
+
+// NewDiscoveryProvider returns a Google Provider that imports from
+// all the active projects the credentials have access to, filtered
+// with the Options.ProjectsInclude and Options.ProjectsExclude.
+// The projects are listed from the Resource Manager API and read
+// at the same time with the same limit of concurrency as the
+// other requests
+func NewDiscoveryProvider(ctx context.Context, maxResults uint64, region, credentials string, opts Options) (provider.Provider, error) {
+	if err := validateOptions(maxResults, region, opts); err != nil {
+		return nil, err
+	}
+	if err := validateProjectPatterns(opts.ProjectsInclude); err != nil {
+		return nil, err
+	}
+	if err := validateProjectPatterns(opts.ProjectsExclude); err != nil {
+		return nil, err
+	}
+
+	existing, err := existingIDs(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// The limits are shared by all the projects
+	// so the quotas of the APIs are respected
+	opts.limits = newAPILimits(opts)
+
+	reader, err := NewGcpReader(ctx, maxResults, "", region, credentials, opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize GCPReader: %v", err)
+	}
+
+	ids, err := discoverProjects(ctx, reader, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, errors.New("no project to import was found with the credentials, check the projects include and exclude patterns")
+	}
+	log.FromContext(ctx).Log("func", "google.NewDiscoveryProvider", "msg", "projects discovered", "projects", strings.Join(ids, ","))
+
+	providers := make([]*google, len(ids))
+	err = parallel(ctx, len(ids), func(ctx context.Context, i int) error {
+		g, err := newProvider(ctx, maxResults, ids[i], region, credentials, opts, existing)
+		if err != nil {
+			return errors.Wrapf(err, "on the project %s", ids[i])
+		}
+		providers[i] = g
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &projects{
+		providers: providers,
+		region:    region,
+		options:   opts,
+	}, nil
+}
+
+// discoverProjects returns the sorted IDs of the
+// active projects that match the opts
+func discoverProjects(ctx context.Context, reader *GCPReader, opts Options) ([]string, error) {
+	list, err := reader.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(list))
+	for _, p := range list {
+		if matchProject(opts, p.ProjectId) {
+			ids = append(ids, p.ProjectId)
+		}
+	}
+	sort.Strings(ids)
+
+	return ids, nil
+}
+
+func (p *projects) Region() string               { return p.region }
+func (p *projects) String() string               { return "google" }
+func (p *projects) TagKey() string               { return "labels" }
+func (p *projects) Source() string               { return "hashicorp/google" }
+func (p *projects) TFClient() interface{}        { return p.providers[0].TFClient() }
+func (p *projects) TFProvider() *schema.Provider { return p.providers[0].TFProvider() }
+func (p *projects) ResourceTypes() []string      { return resourceTypes(p.options) }
+
+func (p *projects) HasResourceType(t string) bool {
+	_, err := ResourceTypeString(t)
+	return err == nil
+}
+
+// sharedResourceTypes are the types of the resources of the customer,
+// organization, folder or billing account, which all the projects list
+var sharedResourceTypes = map[ResourceType]struct{}{
+	CloudIdentityGroup:                   struct{}{},
+	CloudIdentityGroupMembership:         struct{}{},
+	OrganizationIAMCustomRole:            struct{}{},
+	FolderIAMPolicy:                      struct{}{},
+	BillingAccountIAMPolicy:              struct{}{},
+	TagsTagKey:                           struct{}{},
+	TagsTagValue:                         struct{}{},
+	AccessContextManagerAccessLevel:      struct{}{},
+	AccessContextManagerServicePerimeter: struct{}{},
+}
+
+// Resources returns the Resources of the type t of all the projects,
+// in the order of the projects. The ones of the sharedResourceTypes
+// are only returned once. If the API is disabled, or missing permissions,
+// on some of the projects the Resources of the others are returned
+// with the error
+func (p *projects) Resources(ctx context.Context, t string, f *filter.Filter) ([]provider.Resource, error) {
+	var (
+		mu        sync.Mutex
+		apiErrs   *multierror.Error
+		byProject = make([][]provider.Resource, len(p.providers))
+	)
+
+	err := parallel(ctx, len(p.providers), func(ctx context.Context, i int) error {
+		g := p.providers[i]
+		rs, err := g.Resources(ctx, t, f)
+		if err != nil {
+			err = errors.Wrapf(err, "on the project %s", g.Project())
+			if !errors.Is(err, errcode.ErrProviderAPI) {
+				return err
+			}
+			mu.Lock()
+			apiErrs = multierror.Append(apiErrs, err)
+			mu.Unlock()
+		}
+		byProject[i] = rs
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rt, err := ResourceTypeString(t)
+	if err != nil {
+		return nil, err
+	}
+	_, shared := sharedResourceTypes[rt]
+
+	var (
+		resources = make([]provider.Resource, 0)
+		ids       = make(map[string]struct{})
+	)
+	for _, rs := range byProject {
+		for _, r := range rs {
+			if shared {
+				if _, ok := ids[r.ID()]; ok {
+					continue
+				}
+				ids[r.ID()] = struct{}{}
+			}
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, apiErrs.ErrorOrNil()
+}
+
+// Location returns the zone or region of the resource
+// of type t with the id, empty if it has none
+func (p *projects) Location(t, id string) string {
+	return p.providers[0].Location(t, id)
+}
+
+// TypeName returns the name of the resource
+// type t on the Options.ProviderVersion
+func (p *projects) TypeName(t string) string {
+	return typeName(p.options.ProviderVersion, t)
+}
+
+// Configuration returns the region from which the resources
+// are imported, the project is the one of each resource
+func (p *projects) Configuration() map[string]interface{} {
+	return map[string]interface{}{
+		"region": p.region,
+	}
+}
+
+// OptionalConfiguration returns the keys of the Configuration,
+// which are optional on the TF provider, to have them on the
+// provider block
+func (p *projects) OptionalConfiguration() []string {
+	return []string{"region"}
+}
+
+// VersionConstraint returns the constraint of the
+// Options.ProviderVersion, empty if it's not set
+func (p *projects) VersionConstraint() string {
+	return versionConstraint(p.options.ProviderVersion)
+}
+
+// Preflight checks the permissions on each project,
+// the issues are returned in the order of the projects
+func (p *projects) Preflight(ctx context.Context, types []ResourceType) ([]PermissionIssue, error) {
+	byProject := make([][]PermissionIssue, len(p.providers))
+	err := parallel(ctx, len(p.providers), func(ctx context.Context, i int) error {
+		issues, err := p.providers[i].Preflight(ctx, types)
+		if err != nil {
+			return err
+		}
+		byProject[i] = issues
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]PermissionIssue, 0)
+	for _, is := range byProject {
+		issues = append(issues, is...)
+	}
+	return issues, nil
+}
+
+// Summary returns the Summary of each
+// project that has something to report
+func (p *projects) Summary() string {
+	var b strings.Builder
+	for _, g := range p.providers {
+		if sum := g.Summary(); sum != "" {
+			fmt.Fprintf(&b, "Project %s:\n%s", g.Project(), sum)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,155 @@
+package google
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	tfgoogle "github.com/hashicorp/terraform-provider-google/google"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	crmv1 "google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/composer/v1"
+	"google.golang.org/api/option"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+)
+
+func TestMatchProject(t *testing.T) {
+	tests := []struct {
+		name    string
+		include []string
+		exclude []string
+		id      string
+		match   bool
+	}{
+		{name: "NoPatterns", id: "prod-api", match: true},
+		{name: "Included", include: []string{"dev-*", "prod-*"}, id: "prod-api", match: true},
+		{name: "NotIncluded", include: []string{"dev-*"}, id: "prod-api", match: false},
+		{name: "Excluded", exclude: []string{"*-api"}, id: "prod-api", match: false},
+		{name: "IncludedAndExcluded", include: []string{"prod-*"}, exclude: []string{"prod-api"}, id: "prod-api", match: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.match, matchProject(Options{ProjectsInclude: tt.include, ProjectsExclude: tt.exclude}, tt.id))
+		})
+	}
+}
+
+func TestValidateProjectPatterns(t *testing.T) {
+	assert.NoError(t, validateProjectPatterns([]string{"prod-*", "dev-?", "[a-c]-api"}))
+	assert.Error(t, validateProjectPatterns([]string{"prod-["}))
+}
+
+func TestDiscoverProjects(t *testing.T) {
+	ctx := context.Background()
+
+	g := newTestGoogle(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/projects" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "lifecycleState:ACTIVE", r.URL.Query().Get("filter"))
+		if r.URL.Query().Get("pageToken") == "" {
+			w.Write([]byte(`{"projects": [{"projectId": "prod-web"}, {"projectId": "sandbox-1"}], "nextPageToken": "next"}`))
+			return
+		}
+		w.Write([]byte(`{"projects": [{"projectId": "prod-api"}, {"projectId": "dev-api"}]}`))
+	}, func(r *GCPReader, opts ...option.ClientOption) (err error) {
+		r.projects, err = crmv1.NewService(ctx, opts...)
+		return err
+	})
+
+	ids, err := discoverProjects(ctx, g.gcpr, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev-api", "prod-api", "prod-web", "sandbox-1"}, ids)
+
+	ids, err = discoverProjects(ctx, g.gcpr, Options{ProjectsInclude: []string{"prod-*", "dev-*"}, ProjectsExclude: []string{"*-web"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev-api", "prod-api"}, ids)
+}
+
+func TestProjectsResources(t *testing.T) {
+	ctx := context.Background()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/projects/project-a/locations/europe-west1/environments":
+			w.Write([]byte(`{"environments": [{"name": "projects/project-a/locations/europe-west1/environments/airflow"}]}`))
+		case "/v1/projects/project-b/locations/europe-west1/environments":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error": {"code": 403, "message": "disabled", "errors": [
+				{"reason": "accessNotConfigured", "message": "Cloud Composer API has not been used in project 42 before or it is disabled."}
+			]}}`))
+		case "/v1/projects/project-c/locations/europe-west1/environments":
+			w.Write([]byte(`{"environments": [{"name": "projects/project-c/locations/europe-west1/environments/airflow"}]}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+
+	p := &projects{region: "europe-west1"}
+	for _, id := range []string{"project-a", "project-b", "project-c"} {
+		g := newTestGoogle(t, handler, func(r *GCPReader, opts ...option.ClientOption) (err error) {
+			r.composer, err = composer.NewService(ctx, opts...)
+			return err
+		})
+		g.tfGoogleClient.(*tfgoogle.Config).Project = id
+		g.gcpr.project = id
+		p.providers = append(p.providers, g)
+	}
+
+	rs, err := p.Resources(ctx, ComposerEnvironment.String(), &filter.Filter{})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, errcode.ErrProviderAPI))
+	assert.Contains(t, err.Error(), "on the project project-b")
+
+	require.Len(t, rs, 2)
+	assert.Equal(t, "projects/project-a/locations/europe-west1/environments/airflow", rs[0].ID())
+	assert.Equal(t, "projects/project-c/locations/europe-west1/environments/airflow", rs[1].ID())
+
+	// The Resources are read with the
+	// Provider of their own project
+	assert.Equal(t, "project-c", rs[1].Provider().TFClient().(*tfgoogle.Config).Project)
+}
+
+func TestProjectsResourcesSameID(t *testing.T) {
+	ctx := context.Background()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/projects/project-a/global/networks", "/projects/project-b/global/networks":
+			w.Write([]byte(`{"items": [{"name": "default"}]}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+
+	p := &projects{region: "europe-west1"}
+	for _, id := range []string{"project-a", "project-b"} {
+		g := newTestGoogle(t, handler, setCompute)
+		g.tfGoogleClient.(*tfgoogle.Config).Project = id
+		g.gcpr.project = id
+		g.options.Folder = "42"
+		p.providers = append(p.providers, g)
+	}
+
+	// The networks have the same ID on both projects
+	rs, err := p.Resources(ctx, ComputeNetwork.String(), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+	assert.Equal(t, "project-a", rs[0].Provider().TFClient().(*tfgoogle.Config).Project)
+	assert.Equal(t, "project-b", rs[1].Provider().TFClient().(*tfgoogle.Config).Project)
+
+	// The folder is the same for both projects
+	rs, err = p.Resources(ctx, FolderIAMPolicy.String(), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	assert.Equal(t, "folders/42", rs[0].ID())
+}
@@ -3,32 +3,144 @@ package google
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/cycloidio/terracognita/errcode"
 	"github.com/cycloidio/terracognita/filter"
 	"github.com/cycloidio/terracognita/log"
 	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/state"
+	"github.com/cycloidio/terracognita/tag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tfgoogle "github.com/hashicorp/terraform-provider-google/google"
 	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
 )
 
+// disabledAPIReason is the reason of the error returned
+// by the Google APIs when the API is not enabled on the project
+const disabledAPIReason = "accessNotConfigured"
+
 type google struct {
 	tfGoogleClient interface{}
 	tfProvider     *schema.Provider
 	gcpr           *GCPReader
+
+	options Options
+
+	// existing are the resources on the Options.ExistingState
+	// indexed by resource type
+	existing map[string]existingResources
+
+	// retries are the retries done while
+	// listing the resources of each type
+	retries *retryStats
+
+	// timings are the time spent listing the resources
+	// of each type, only set with the Options.Timings
+	timings *listTimings
+
+	// overThreshold are the resource types with more
+	// resources than the Options.ResourcesThreshold
+	// and how many they had, when only warning
+	overThreshold   map[string]int
+	overThresholdMu sync.Mutex
 }
 
 // NewProvider returns a Gooogle Provider
-func NewProvider(ctx context.Context, maxResults uint64, project, region, credentials string) (provider.Provider, error) {
+func NewProvider(ctx context.Context, maxResults uint64, project, region, credentials string, opts Options) (provider.Provider, error) {
+	if err := validateOptions(maxResults, region, opts); err != nil {
+		return nil, err
+	}
+
+	existing, err := existingIDs(opts)
+	if err != nil {
+		return nil, err
+	}
+	opts.limits = newAPILimits(opts)
+
+	return newProvider(ctx, maxResults, project, region, credentials, opts, existing)
+}
+
+// validateOptions checks the configuration
+// shared by all the projects of a Provider
+func validateOptions(maxResults uint64, region string, opts Options) error {
+	if err := validateMaxResults(maxResults); err != nil {
+		return err
+	}
+	if err := validateEndpoints(opts.Endpoints); err != nil {
+		return err
+	}
+	if err := validateEmulators(emulators(opts)); err != nil {
+		return err
+	}
+	if err := validateRawFilters(opts.RawFilters); err != nil {
+		return err
+	}
+	if err := validateZones(region, opts.Zones); err != nil {
+		return err
+	}
+	if err := validateHTTPClient(opts); err != nil {
+		return err
+	}
+	if err := validateProviderVersion(opts.ProviderVersion); err != nil {
+		return err
+	}
+	if err := validateMandatoryLabels(opts.MandatoryLabels); err != nil {
+		return err
+	}
+	if err := validateConcurrency(opts.MaxConcurrency, opts.APIConcurrency); err != nil {
+		return err
+	}
+	return nil
+}
+
+// existingIDs returns the resources on the
+// Options.ExistingState indexed by resource type
+func existingIDs(opts Options) (map[string]existingResources, error) {
+	if opts.ExistingState == nil {
+		return nil, nil
+	}
+	ids, err := state.ResourceIDs(opts.ExistingState)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the existing state")
+	}
+	existing := make(map[string]existingResources, len(ids))
+	for t, tids := range ids {
+		existing[t] = newExistingResources(tids)
+	}
+	return existing, nil
+}
+
+// newProvider returns the Google Provider of the project
+// with the already validated opts and existing IDs
+func newProvider(ctx context.Context, maxResults uint64, project, region, credentials string, opts Options, existing map[string]existingResources) (*google, error) {
+	// The TF Config accepts the path or the content of
+	// the credentials and uses the Application Default
+	// Credentials if it's empty
+	tfCredentials := credentials
+	if tfCredentials == "" {
+		tfCredentials = opts.CredentialsJSON
+	}
+
 	cfg := tfgoogle.Config{
-		Credentials: credentials,
+		Credentials: tfCredentials,
 		Project:     project,
 		Region:      region,
 	}
 
 	tfgoogle.ConfigureBasePaths(&cfg)
-	log.Get().Log("func", "google.NewProvider", "msg", "loading TF client")
+	configureTFEndpoints(&cfg, opts.Endpoints)
+	configureTFEmulators(&cfg, emulators(opts))
+	if onlyEmulators(credentials, opts) {
+		cfg.AccessToken = emulatorToken
+	}
+	log.FromContext(ctx).Log("func", "google.NewProvider", "msg", "loading TF client", "project", project)
 	if err := cfg.LoadAndValidate(ctx); err != nil {
 		return nil, fmt.Errorf("could not initialize 'terraform/google.Config.LoadAndValidate()' because: %s", err)
 	}
@@ -36,17 +148,25 @@ func NewProvider(ctx context.Context, maxResults uint64, project, region, creden
 	tfp := tfgoogle.Provider()
 	tfp.SetMeta(&cfg)
 
-	log.Get().Log("func", "google.NewProvider", "msg", "loading GCP client")
-	reader, err := NewGcpReader(ctx, maxResults, project, region, credentials)
+	log.FromContext(ctx).Log("func", "google.NewProvider", "msg", "loading GCP client", "project", project)
+	reader, err := NewGcpReader(ctx, maxResults, project, region, credentials, opts)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize GCPReader: %v", err)
 	}
 
-	return &google{
+	g := &google{
 		tfGoogleClient: &cfg,
 		tfProvider:     tfp,
 		gcpr:           reader,
-	}, nil
+		options:        opts,
+		existing:       existing,
+		retries:        newRetryStats(),
+	}
+	if opts.Timings {
+		g.timings = newListTimings()
+	}
+
+	return g, nil
 }
 
 func (g *google) HasResourceType(t string) bool {
@@ -54,15 +174,14 @@ func (g *google) HasResourceType(t string) bool {
 	return err == nil
 }
 
-func (g *google) Region() string                        { return g.tfGoogleClient.(*tfgoogle.Config).Region }
-func (g *google) Project() string                       { return g.tfGoogleClient.(*tfgoogle.Config).Project }
-func (g *google) String() string                        { return "google" }
-func (g *google) TagKey() string                        { return "labels" }
-func (g *google) Source() string                        { return "hashicorp/google" }
-func (g *google) Configuration() map[string]interface{} { return make(map[string]interface{}) }
+func (g *google) Region() string  { return g.tfGoogleClient.(*tfgoogle.Config).Region }
+func (g *google) Project() string { return g.tfGoogleClient.(*tfgoogle.Config).Project }
+func (g *google) String() string  { return "google" }
+func (g *google) TagKey() string  { return "labels" }
+func (g *google) Source() string  { return "hashicorp/google" }
 
 func (g *google) ResourceTypes() []string {
-	return ResourceTypeStrings()
+	return resourceTypes(g.options)
 }
 
 func (g *google) Resources(ctx context.Context, t string, f *filter.Filter) ([]provider.Resource, error) {
@@ -76,14 +195,239 @@ func (g *google) Resources(ctx context.Context, t string, f *filter.Filter) ([]p
 		return nil, errors.Errorf("the resource %q it's not implemented", t)
 	}
 
-	resources, err := rfn(ctx, g, t, f)
+	if _, ok := experimentalResourceTypes[rt]; ok && !g.options.Experimental {
+		log.FromContext(ctx).Log("func", "google.Resources", "msg", "skipping the experimental resource type, it has to be enabled", "resource", t)
+		return nil, nil
+	}
+
+	f = g.withMandatoryLabels(f)
+
+	start := time.Now()
+	resources, err := rfn(withRetryStats(ctx, g.retries, t), g, t, f)
+	if g.timings != nil {
+		g.timings.add(t, time.Since(start))
+	}
 	if err != nil {
-		return nil, errors.Wrapf(err, "error while reading from resource %q", t)
+		// if the API is disabled we return an error that
+		// is an errcode.ErrProviderAPI so the import
+		// continues with the other resources
+		return nil, g.listError(t, err)
+	}
+
+	if err := g.checkThreshold(ctx, t, len(resources)); err != nil {
+		return nil, err
+	}
+
+	valid := make([]provider.Resource, 0, len(resources))
+	for _, r := range resources {
+		if !validID(rt, r.ID()) {
+			log.FromContext(ctx).Log("func", "google.Resources", "msg", "skipping the resource with a malformed ID", "resource", t, "id", r.ID())
+			continue
+		}
+		valid = append(valid, r)
+	}
+	resources = valid
+
+	if f != nil && f.NamePrefix != "" {
+		filtered := make([]provider.Resource, 0, len(resources))
+		for _, r := range resources {
+			if matchNamePrefix(f, rt, r.ID()) {
+				filtered = append(filtered, r)
+			}
+		}
+		resources = filtered
+	}
+
+	if g.options.ResourceFilter != nil {
+		filtered := make([]provider.Resource, 0, len(resources))
+		for _, r := range resources {
+			if g.options.ResourceFilter(r) {
+				filtered = append(filtered, r)
+			}
+		}
+		resources = filtered
+	}
+
+	if er, ok := g.existing[t]; ok {
+		filtered := make([]provider.Resource, 0, len(resources))
+		for _, r := range resources {
+			if !er.has(g.Project(), r.ID()) {
+				filtered = append(filtered, r)
+			}
+		}
+		log.FromContext(ctx).Log("func", "google.Resources", "msg", "skipping the resources already on the existing state", "resource", t, "skipped", len(resources)-len(filtered))
+		resources = filtered
+	}
+
+	if max := g.options.MaxResourcesPerType; max > 0 && len(resources) > max {
+		log.FromContext(ctx).Log("func", "google.Resources", "msg", "sampling the resources, the rest will not be imported", "resource", t, "total", len(resources), "max", max)
+		resources = resources[:max]
+	}
+
+	if enrich, ok := enrichers[rt]; ok {
+		for i, r := range resources {
+			resources[i] = &enrichedResource{Resource: r, enrich: enrich}
+		}
 	}
 
 	return resources, nil
 }
 
+// checkThreshold checks that the count of resources listed of
+// the type t is not over the Options.ResourcesThreshold. If it's
+// over it returns an error or, with the Options.ResourcesThresholdWarn,
+// records it to be reported on the Summary
+func (g *google) checkThreshold(ctx context.Context, t string, count int) error {
+	max := g.options.ResourcesThreshold
+	if max <= 0 || count <= max {
+		return nil
+	}
+
+	if !g.options.ResourcesThresholdWarn {
+		return errors.Wrapf(errcode.ErrProviderResourcesThreshold, "%s has %d resources, more than the threshold of %d: narrow the filters or raise the threshold", t, count, max)
+	}
+
+	log.FromContext(ctx).Log("func", "google.Resources", "msg", "the resource type has more resources than the threshold", "resource", t, "total", count, "threshold", max)
+
+	g.overThresholdMu.Lock()
+	defer g.overThresholdMu.Unlock()
+	if g.overThreshold == nil {
+		g.overThreshold = make(map[string]int)
+	}
+	g.overThreshold[t] = count
+
+	return nil
+}
+
+// Location returns the zone or region of the resource
+// of type t with the id, empty if it has none
+func (g *google) Location(t, id string) string {
+	rt, err := ResourceTypeString(t)
+	if err != nil {
+		return ""
+	}
+	return resourceLocation(rt, id, g.Region())
+}
+
+// withMandatoryLabels returns a copy of the f with the Options.MandatoryLabels
+// added to its Tags, so they are ANDed with the ones of the user
+func (g *google) withMandatoryLabels(f *filter.Filter) *filter.Filter {
+	if len(g.options.MandatoryLabels) == 0 {
+		return f
+	}
+	nf := &filter.Filter{}
+	if f != nil {
+		*nf = *f
+	}
+	nf.Tags = make([]tag.Tag, 0, len(nf.Tags)+len(g.options.MandatoryLabels))
+	if f != nil {
+		nf.Tags = append(nf.Tags, f.Tags...)
+	}
+	nf.Tags = append(nf.Tags, g.options.MandatoryLabels...)
+	return nf
+}
+
+// Configuration returns the project and region
+// from which the resources are imported
+func (g *google) Configuration() map[string]interface{} {
+	return map[string]interface{}{
+		"project": g.Project(),
+		"region":  g.Region(),
+	}
+}
+
+// OptionalConfiguration returns the keys of the Configuration,
+// which are optional on the TF provider, to have them on the
+// provider block
+func (g *google) OptionalConfiguration() []string {
+	return []string{"project", "region"}
+}
+
+// TypeName returns the name of the resource
+// type t on the Options.ProviderVersion
+func (g *google) TypeName(t string) string {
+	return typeName(g.options.ProviderVersion, t)
+}
+
+// VersionConstraint returns the constraint of the
+// Options.ProviderVersion, empty if it's not set
+func (g *google) VersionConstraint() string {
+	return versionConstraint(g.options.ProviderVersion)
+}
+
+// Summary returns the retries done on the requests
+// to the Google APIs of each resource type and the
+// time waited for them, followed by the time spent
+// listing each type if the Options.Timings is set
+// and the types over the Options.ResourcesThreshold
+func (g *google) Summary() string {
+	var sum string
+	if g.retries != nil {
+		sum = g.retries.summary()
+	}
+	if g.timings != nil {
+		sum += g.timings.summary()
+	}
+
+	g.overThresholdMu.Lock()
+	defer g.overThresholdMu.Unlock()
+	if len(g.overThreshold) != 0 {
+		types := make([]string, 0, len(g.overThreshold))
+		for t := range g.overThreshold {
+			types = append(types, t)
+		}
+		sort.Strings(types)
+
+		var b strings.Builder
+		fmt.Fprintf(&b, "Resource types with more resources than the threshold of %d:\n", g.options.ResourcesThreshold)
+		for _, t := range types {
+			fmt.Fprintf(&b, "  - %s: %d resources\n", t, g.overThreshold[t])
+		}
+		sum += b.String()
+	}
+
+	return sum
+}
+
+// disabledAPI checks if the err is because the API is
+// not enabled on the project and returns the API name
+func disabledAPI(err error) (string, bool) {
+	var gerr *googleapi.Error
+	if !errors.As(err, &gerr) || gerr.Code != http.StatusForbidden {
+		return "", false
+	}
+	for _, e := range gerr.Errors {
+		if e.Reason != disabledAPIReason {
+			continue
+		}
+		// The message has the format:
+		// <API name> API has not been used in project <project> before or it is disabled. ...
+		if i := strings.Index(e.Message, " API has not been used"); i != -1 {
+			return e.Message[:i], true
+		}
+		return "Google", true
+	}
+	return "", false
+}
+
+// permissionDenied checks if the err is because the
+// credentials do not have the permissions needed
+func permissionDenied(err error) bool {
+	var gerr *googleapi.Error
+	if !errors.As(err, &gerr) || gerr.Code != http.StatusForbidden {
+		return false
+	}
+	_, ok := disabledAPI(err)
+	return !ok && !rateLimited(err)
+}
+
+// notFound checks if the err is because
+// the requested resource does not exist
+func notFound(err error) bool {
+	var gerr *googleapi.Error
+	return errors.As(err, &gerr) && gerr.Code == http.StatusNotFound
+}
+
 func (g *google) TFClient() interface{} {
 	return g.tfGoogleClient
 }
@@ -0,0 +1,404 @@
+package google
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	tfgoogle "github.com/hashicorp/terraform-provider-google/google"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/googleapi"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/hcl"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/tag"
+	"github.com/cycloidio/terracognita/writer"
+)
+
+func TestResources(t *testing.T) {
+	t.Run("ResourceFilter", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			rt  = ComputeNetwork
+			g   = &google{
+				tfProvider: tfgoogle.Provider(),
+				options: Options{
+					ResourceFilter: func(r provider.Resource) bool {
+						return r.ID() != "drop"
+					},
+				},
+			}
+		)
+
+		rfn := resources[rt]
+		defer func() { resources[rt] = rfn }()
+		resources[rt] = func(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+			return []provider.Resource{
+				provider.NewResource("keep", resourceType, g),
+				provider.NewResource("drop", resourceType, g),
+			}, nil
+		}
+
+		rs, err := g.Resources(ctx, rt.String(), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "keep", rs[0].ID())
+	})
+	t.Run("MandatoryLabels", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			rt  = ComputeInstance
+			g   = &google{
+				tfProvider: tfgoogle.Provider(),
+				options: Options{
+					MandatoryLabels: []tag.Tag{{Name: "environment", Value: "prod"}},
+				},
+			}
+			f = &filter.Filter{Tags: []tag.Tag{{Name: "team", Value: "data"}}}
+		)
+
+		rfn := resources[rt]
+		defer func() { resources[rt] = rfn }()
+		resources[rt] = func(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+			assert.Equal(t, `(labels.team="data") (labels.environment="prod") `, initializeFilter(filters))
+			assert.False(t, matchLabels(map[string]string{"team": "data"}, filters))
+			assert.True(t, matchLabels(map[string]string{"team": "data", "environment": "prod"}, filters))
+			return nil, nil
+		}
+
+		_, err := g.Resources(ctx, rt.String(), f)
+		require.NoError(t, err)
+		// The filter of the user is not changed
+		assert.Equal(t, []tag.Tag{{Name: "team", Value: "data"}}, f.Tags)
+	})
+	t.Run("MaxResourcesPerType", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			rt  = ComputeNetwork
+			g   = &google{
+				tfProvider: tfgoogle.Provider(),
+				options: Options{
+					MaxResourcesPerType: 2,
+				},
+			}
+		)
+
+		rfn := resources[rt]
+		defer func() { resources[rt] = rfn }()
+		resources[rt] = func(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+			return []provider.Resource{
+				provider.NewResource("1", resourceType, g),
+				provider.NewResource("2", resourceType, g),
+				provider.NewResource("3", resourceType, g),
+			}, nil
+		}
+
+		rs, err := g.Resources(ctx, rt.String(), &filter.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, rs, 2)
+
+		g.options.MaxResourcesPerType = 0
+		rs, err = g.Resources(ctx, rt.String(), &filter.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, rs, 3)
+	})
+	t.Run("ResourcesThreshold", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			rt  = ComputeNetwork
+			g   = &google{
+				tfProvider: tfgoogle.Provider(),
+				options: Options{
+					ResourcesThreshold: 2,
+				},
+			}
+		)
+
+		rfn := resources[rt]
+		defer func() { resources[rt] = rfn }()
+		resources[rt] = func(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+			return []provider.Resource{
+				provider.NewResource("1", resourceType, g),
+				provider.NewResource("2", resourceType, g),
+				provider.NewResource("3", resourceType, g),
+			}, nil
+		}
+
+		_, err := g.Resources(ctx, rt.String(), &filter.Filter{})
+		assert.True(t, errors.Is(err, errcode.ErrProviderResourcesThreshold))
+		assert.False(t, errors.Is(err, errcode.ErrProviderAPI), "the import is not continued")
+		assert.Equal(t, "", g.Summary())
+
+		g.options.ResourcesThresholdWarn = true
+		rs, err := g.Resources(ctx, rt.String(), &filter.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, rs, 3)
+		assert.Equal(t, "Resource types with more resources than the threshold of 2:\n  - google_compute_network: 3 resources\n", g.Summary())
+
+		g.options.ResourcesThreshold = 3
+		g.options.ResourcesThresholdWarn = false
+		rs, err = g.Resources(ctx, rt.String(), &filter.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, rs, 3)
+	})
+	t.Run("Existing", func(t *testing.T) {
+		// The state IDs are the ones set by
+		// the version 3.67 of the TF provider
+		tfstate := `{
+  "version": 4,
+  "terraform_version": "0.13.5",
+  "serial": 3,
+  "lineage": "0f3f4c4e-3c1e-6d4e-1b2c-4a9c5f6d7e8f",
+  "outputs": {},
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "google_compute_network",
+      "name": "default",
+      "provider": "provider[\"registry.terraform.io/hashicorp/google\"]",
+      "instances": [
+        {
+          "schema_version": 0,
+          "attributes": {
+            "id": "projects/my-project/global/networks/default",
+            "name": "default",
+            "project": "my-project",
+            "self_link": "https://www.googleapis.com/compute/v1/projects/my-project/global/networks/default"
+          }
+        }
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "google_compute_network",
+      "name": "shared",
+      "provider": "provider[\"registry.terraform.io/hashicorp/google\"]",
+      "instances": [
+        {
+          "schema_version": 0,
+          "attributes": {
+            "id": "projects/other-project/global/networks/shared",
+            "name": "shared",
+            "project": "other-project"
+          }
+        }
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "google_compute_instance",
+      "name": "vm",
+      "provider": "provider[\"registry.terraform.io/hashicorp/google\"]",
+      "instances": [
+        {
+          "schema_version": 6,
+          "attributes": {
+            "id": "projects/my-project/zones/europe-west1-b/instances/vm",
+            "name": "vm",
+            "project": "my-project",
+            "zone": "europe-west1-b"
+          }
+        }
+      ]
+    }
+  ]
+}`
+
+		var (
+			ctx = context.Background()
+			g   = &google{
+				tfGoogleClient: &tfgoogle.Config{Project: "my-project"},
+				tfProvider:     tfgoogle.Provider(),
+			}
+			err error
+		)
+		g.existing, err = existingIDs(Options{ExistingState: strings.NewReader(tfstate)})
+		require.NoError(t, err)
+
+		listed := map[ResourceType][]string{
+			ComputeNetwork:  []string{"default", "shared", "new"},
+			ComputeInstance: []string{"my-project/europe-west1-b/vm", "my-project/europe-west1-c/vm"},
+		}
+		for rt, ids := range listed {
+			rfn := resources[rt]
+			defer func(rt ResourceType) { resources[rt] = rfn }(rt)
+			ids := ids
+			resources[rt] = func(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+				var rs []provider.Resource
+				for _, id := range ids {
+					rs = append(rs, provider.NewResource(id, resourceType, g))
+				}
+				return rs, nil
+			}
+		}
+
+		rs, err := g.Resources(ctx, ComputeNetwork.String(), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 2)
+		assert.Equal(t, "shared", rs[0].ID())
+		assert.Equal(t, "new", rs[1].ID())
+
+		rs, err = g.Resources(ctx, ComputeInstance.String(), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "my-project/europe-west1-c/vm", rs[0].ID())
+	})
+	t.Run("DisabledAPI", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			rt  = FilestoreInstance
+			g   = &google{
+				tfProvider: tfgoogle.Provider(),
+			}
+		)
+
+		rfn := resources[rt]
+		defer func() { resources[rt] = rfn }()
+		resources[rt] = func(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+			return nil, errors.Wrap(&googleapi.Error{
+				Code: http.StatusForbidden,
+				Errors: []googleapi.ErrorItem{
+					{
+						Reason:  "accessNotConfigured",
+						Message: "Cloud Filestore API has not been used in project 42 before or it is disabled.",
+					},
+				},
+			}, "unable to list filestore instances from reader")
+		}
+
+		_, err := g.Resources(ctx, rt.String(), &filter.Filter{})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errcode.ErrProviderAPI))
+		assert.Contains(t, err.Error(), "because the Cloud Filestore API is disabled")
+
+		g.options.StrictAPIs = true
+		_, err = g.Resources(ctx, rt.String(), &filter.Filter{})
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, errcode.ErrProviderAPI))
+	})
+	t.Run("MalformedID", func(t *testing.T) {
+		tcs := []struct {
+			Name  string
+			RT    ResourceType
+			ID    string
+			Valid bool
+		}{
+			{Name: "ComputeInstance", RT: ComputeInstance, ID: "project/zone/name", Valid: true},
+			{Name: "ComputeInstanceEmptyZone", RT: ComputeInstance, ID: "project//name"},
+			{Name: "ComputeInstanceEmptyName", RT: ComputeInstance, ID: "project/zone/"},
+			{Name: "DNSRecordSet", RT: DNSRecordSet, ID: "zone/www.example.com./A", Valid: true},
+			{Name: "DNSRecordSetEmptyZone", RT: DNSRecordSet, ID: "/www.example.com./A"},
+			{Name: "DNSRecordSetEmptyName", RT: DNSRecordSet, ID: "zone//A"},
+		}
+		for _, tc := range tcs {
+			t.Run(tc.Name, func(t *testing.T) {
+				var (
+					ctx = context.Background()
+					g   = &google{
+						tfProvider: tfgoogle.Provider(),
+					}
+				)
+
+				rfn := resources[tc.RT]
+				defer func() { resources[tc.RT] = rfn }()
+				resources[tc.RT] = func(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+					return []provider.Resource{
+						provider.NewResource(tc.ID, resourceType, g),
+					}, nil
+				}
+
+				rs, err := g.Resources(ctx, tc.RT.String(), &filter.Filter{})
+				require.NoError(t, err)
+				if tc.Valid {
+					assert.Len(t, rs, 1)
+				} else {
+					assert.Len(t, rs, 0)
+				}
+			})
+		}
+	})
+	t.Run("Experimental", func(t *testing.T) {
+		var (
+			ctx    = context.Background()
+			rt     = BinaryAuthorizationAttestor
+			called bool
+			g      = &google{
+				tfProvider: tfgoogle.Provider(),
+			}
+		)
+
+		rfn := resources[rt]
+		defer func() { resources[rt] = rfn }()
+		resources[rt] = func(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+			called = true
+			return []provider.Resource{
+				provider.NewResource("projects/my-project/attestors/built-by-ci", resourceType, g),
+			}, nil
+		}
+
+		assert.NotContains(t, g.ResourceTypes(), rt.String())
+		rs, err := g.Resources(ctx, rt.String(), &filter.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, rs, 0)
+		assert.False(t, called)
+
+		g.options.Experimental = true
+		assert.Contains(t, g.ResourceTypes(), rt.String())
+		rs, err = g.Resources(ctx, rt.String(), &filter.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, rs, 1)
+		assert.True(t, called)
+	})
+}
+
+func TestPermissionDenied(t *testing.T) {
+	assert.True(t, permissionDenied(errors.Wrap(&googleapi.Error{
+		Code: http.StatusForbidden,
+		Errors: []googleapi.ErrorItem{
+			{Reason: "forbidden"},
+		},
+	}, "unable to list cloudidentity Group from google APIs")))
+	assert.False(t, permissionDenied(&googleapi.Error{
+		Code: http.StatusForbidden,
+		Errors: []googleapi.ErrorItem{
+			{Reason: "accessNotConfigured"},
+		},
+	}))
+	assert.False(t, permissionDenied(&googleapi.Error{Code: http.StatusNotFound}))
+	assert.False(t, permissionDenied(errors.New("some error")))
+}
+
+func TestNotFound(t *testing.T) {
+	assert.True(t, notFound(errors.Wrap(&googleapi.Error{
+		Code: http.StatusNotFound,
+	}, "unable to get appengine Application from google APIs")))
+	assert.False(t, notFound(&googleapi.Error{Code: http.StatusForbidden}))
+	assert.False(t, notFound(errors.New("some error")))
+}
+
+func TestProviderBlock(t *testing.T) {
+	var (
+		b strings.Builder
+		g = &google{
+			tfGoogleClient: &tfgoogle.Config{Project: "pid", Region: "europe-west1"},
+			tfProvider:     tfgoogle.Provider(),
+			options:        Options{ProviderVersion: 4},
+		}
+	)
+
+	hw := hcl.NewWriter(&b, g, &writer.Options{HCLProviderBlock: true})
+	require.NoError(t, hw.Sync())
+
+	out := b.String()
+	assert.Contains(t, out, `version = "~> 4.0"`)
+	assert.Contains(t, out, `provider "google" {`)
+	assert.Contains(t, out, `project = var.project`)
+	assert.Contains(t, out, `region  = var.region`)
+	assert.Contains(t, out, `default = "pid"`)
+	assert.Contains(t, out, `default = "europe-west1"`)
+}
@@ -2,73 +2,308 @@ package google
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
 
+	"google.golang.org/api/accesscontextmanager/v1"
+	"google.golang.org/api/appengine/v1"
+	bigtableadmin "google.golang.org/api/bigtableadmin/v2"
+	"google.golang.org/api/binaryauthorization/v1"
+	"google.golang.org/api/cloudbilling/v1"
+	"google.golang.org/api/cloudidentity/v1"
+	crmv1 "google.golang.org/api/cloudresourcemanager/v1"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/cloudscheduler/v1"
+	"google.golang.org/api/cloudtasks/v2"
+	"google.golang.org/api/composer/v1"
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/dataproc/v1"
 	"google.golang.org/api/dns/v1"
+	"google.golang.org/api/eventarc/v1"
+	"google.golang.org/api/file/v1"
 	"google.golang.org/api/iam/v1"
+	"google.golang.org/api/iap/v1"
+	logging "google.golang.org/api/logging/v2"
+	monitoring "google.golang.org/api/monitoring/v3"
 	"google.golang.org/api/option"
+	"google.golang.org/api/osconfig/v1"
+	"google.golang.org/api/pubsub/v1"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
 	"google.golang.org/api/storage/v1"
+	"google.golang.org/api/storagetransfer/v1"
+	"google.golang.org/api/workflows/v1"
 )
 
 //go:generate go run ./cmd
 
+// maxPageSize is the maximum page size accepted
+// by all the List calls of the Google APIs used
+const maxPageSize = 500
+
 // GCPReader is the middleware between TC and GCP
 type GCPReader struct {
-	compute    *compute.Service
-	storage    *storage.Service
-	sqladmin   *sqladmin.Service
-	dns        *dns.Service
-	iam        *iam.Service
-	project    string
-	region     string
-	zones      []string
-	maxResults uint64
+	compute        *compute.Service
+	storage        *storage.Service
+	sqladmin       *sqladmin.Service
+	dns            *dns.Service
+	iam            *iam.Service
+	cloudscheduler *cloudscheduler.Service
+	cloudtasks     *cloudtasks.Service
+	file           *file.Service
+	pubsub         *pubsub.Service
+	logging        *logging.Service
+	monitoring     *monitoring.Service
+	dataproc       *dataproc.Service
+	iap            *iap.Service
+	cloudidentity  *cloudidentity.Service
+	appengine      *appengine.APIService
+	bigtableadmin  *bigtableadmin.Service
+	cloudbilling   *cloudbilling.APIService
+	workflows      *workflows.Service
+	eventarc       *eventarc.Service
+	osconfig       *osconfig.Service
+	transfer       *storagetransfer.Service
+	tags           *cloudresourcemanager.Service
+	projects       *crmv1.Service
+	accesscontext  *accesscontextmanager.Service
+	composer       *composer.Service
+	binauthz       *binaryauthorization.Service
+	project        string
+	region         string
+	zones          []string
+	maxResults     uint64
+
+	// lists caches the results of the List calls
+	// done with this reader
+	lists *listCache
 }
 
 // NewGcpReader returns a GCPReader with a catalog of services
 // ready to be used
-func NewGcpReader(ctx context.Context, maxResults uint64, project, region, credentials string) (*GCPReader, error) {
-	if maxResults > 500 {
-		return nil, errors.New("max-results must be between 0 and 500, inclusive")
+func NewGcpReader(ctx context.Context, maxResults uint64, project, region, credentials string, opts Options) (*GCPReader, error) {
+	if err := validateMaxResults(maxResults); err != nil {
+		return nil, err
+	}
+	if err := validateEndpoints(opts.Endpoints); err != nil {
+		return nil, err
+	}
+	emus := emulators(opts)
+	if err := validateEmulators(emus); err != nil {
+		return nil, err
+	}
+	if err := validateHTTPClient(opts); err != nil {
+		return nil, err
+	}
+	if err := validateConcurrency(opts.MaxConcurrency, opts.APIConcurrency); err != nil {
+		return nil, err
+	}
+	client, err := httpClient(ctx, credentials, opts)
+	if err != nil {
+		return nil, err
 	}
-	comp, err := compute.NewService(ctx, option.WithCredentialsFile(credentials))
+	limits := opts.limits
+	if limits == nil {
+		limits = newAPILimits(opts)
+	}
+	// Each API has its own client so the
+	// requests are limited by API
+	co := func(api string) option.ClientOption {
+		c := client
+		if _, ok := emus[api]; ok && opts.HTTPClient == nil {
+			c = emulatorClient(opts)
+		}
+		return option.WithHTTPClient(limits.client(c, api))
+	}
+	// ep returns the base path of the api, on
+	// its emulator if it has one
+	ep := func(api, basePath string) string {
+		if h, ok := emus[api]; ok {
+			return emulatorEndpoint(h, basePath)
+		}
+		return endpoint(opts.Endpoints, api, basePath)
+	}
+	comp, err := compute.NewService(ctx, co("compute"))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create compute service")
 	}
-	storage, err := storage.NewService(ctx, option.WithCredentialsFile(credentials))
+	storage, err := storage.NewService(ctx, co("storage"))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create storage service")
 	}
-	sql, err := sqladmin.NewService(ctx, option.WithCredentialsFile(credentials))
+	sql, err := sqladmin.NewService(ctx, co("sqladmin"))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create sqladmin service")
 	}
-	d, err := dns.NewService(ctx, option.WithCredentialsFile(credentials))
+	d, err := dns.NewService(ctx, co("dns"))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create sqladmin service")
 	}
-	i, err := iam.NewService(ctx, option.WithCredentialsFile(credentials))
+	i, err := iam.NewService(ctx, co("iam"))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create iam service")
 	}
+	cs, err := cloudscheduler.NewService(ctx, co("cloudscheduler"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create cloudscheduler service")
+	}
+	ct, err := cloudtasks.NewService(ctx, co("cloudtasks"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create cloudtasks service")
+	}
+	f, err := file.NewService(ctx, co("file"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create file service")
+	}
+	ps, err := pubsub.NewService(ctx, co("pubsub"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create pubsub service")
+	}
+	l, err := logging.NewService(ctx, co("logging"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create logging service")
+	}
+	m, err := monitoring.NewService(ctx, co("monitoring"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create monitoring service")
+	}
+	dp, err := dataproc.NewService(ctx, co("dataproc"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create dataproc service")
+	}
+	ia, err := iap.NewService(ctx, co("iap"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create iap service")
+	}
+	ci, err := cloudidentity.NewService(ctx, co("cloudidentity"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create cloudidentity service")
+	}
+	ae, err := appengine.NewService(ctx, co("appengine"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create appengine service")
+	}
+	bt, err := bigtableadmin.NewService(ctx, co("bigtableadmin"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create bigtableadmin service")
+	}
+	cb, err := cloudbilling.NewService(ctx, co("cloudbilling"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create cloudbilling service")
+	}
+	wf, err := workflows.NewService(ctx, co("workflows"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create workflows service")
+	}
+	ea, err := eventarc.NewService(ctx, co("eventarc"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create eventarc service")
+	}
+	oc, err := osconfig.NewService(ctx, co("osconfig"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create osconfig service")
+	}
+	st, err := storagetransfer.NewService(ctx, co("storagetransfer"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create storagetransfer service")
+	}
+
+	tg, err := cloudresourcemanager.NewService(ctx, co("cloudresourcemanager"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create cloudresourcemanager service")
+	}
+	pj, err := crmv1.NewService(ctx, co("cloudresourcemanager"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create cloudresourcemanager v1 service")
+	}
+	acm, err := accesscontextmanager.NewService(ctx, co("accesscontextmanager"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create accesscontextmanager service")
+	}
+	cp, err := composer.NewService(ctx, co("composer"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create composer service")
+	}
+	ba, err := binaryauthorization.NewService(ctx, co("binaryauthorization"))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create binaryauthorization service")
+	}
+
+	comp.BasePath = ep("compute", comp.BasePath)
+	storage.BasePath = ep("storage", storage.BasePath)
+	sql.BasePath = ep("sqladmin", sql.BasePath)
+	d.BasePath = ep("dns", d.BasePath)
+	i.BasePath = ep("iam", i.BasePath)
+	cs.BasePath = ep("cloudscheduler", cs.BasePath)
+	ct.BasePath = ep("cloudtasks", ct.BasePath)
+	f.BasePath = ep("file", f.BasePath)
+	ps.BasePath = ep("pubsub", ps.BasePath)
+	l.BasePath = ep("logging", l.BasePath)
+	m.BasePath = ep("monitoring", m.BasePath)
+	dp.BasePath = ep("dataproc", dp.BasePath)
+	ia.BasePath = ep("iap", ia.BasePath)
+	ci.BasePath = ep("cloudidentity", ci.BasePath)
+	ae.BasePath = ep("appengine", ae.BasePath)
+	bt.BasePath = ep("bigtableadmin", bt.BasePath)
+	cb.BasePath = ep("cloudbilling", cb.BasePath)
+	wf.BasePath = ep("workflows", wf.BasePath)
+	ea.BasePath = ep("eventarc", ea.BasePath)
+	oc.BasePath = ep("osconfig", oc.BasePath)
+	st.BasePath = ep("storagetransfer", st.BasePath)
+	tg.BasePath = ep("cloudresourcemanager", tg.BasePath)
+	pj.BasePath = ep("cloudresourcemanager", pj.BasePath)
+	acm.BasePath = ep("accesscontextmanager", acm.BasePath)
+	cp.BasePath = ep("composer", cp.BasePath)
+	ba.BasePath = ep("binaryauthorization", ba.BasePath)
+
 	return &GCPReader{
-		compute:    comp,
-		storage:    storage,
-		sqladmin:   sql,
-		project:    project,
-		region:     region,
-		dns:        d,
-		iam:        i,
-		zones:      []string{},
-		maxResults: maxResults,
+		lists:          newListCache(),
+		compute:        comp,
+		storage:        storage,
+		sqladmin:       sql,
+		project:        project,
+		region:         region,
+		dns:            d,
+		iam:            i,
+		cloudscheduler: cs,
+		cloudtasks:     ct,
+		file:           f,
+		pubsub:         ps,
+		logging:        l,
+		monitoring:     m,
+		dataproc:       dp,
+		iap:            ia,
+		cloudidentity:  ci,
+		appengine:      ae,
+		bigtableadmin:  bt,
+		cloudbilling:   cb,
+		workflows:      wf,
+		eventarc:       ea,
+		osconfig:       oc,
+		transfer:       st,
+		tags:           tg,
+		projects:       pj,
+		accesscontext:  acm,
+		composer:       cp,
+		binauthz:       ba,
+		zones:          append([]string{}, opts.Zones...),
+		maxResults:     maxResults,
 	}, nil
 }
 
+// validateMaxResults checks that the maxResults, used as page
+// size of the List calls, is on the range accepted by the APIs
+func validateMaxResults(maxResults uint64) error {
+	if maxResults > maxPageSize {
+		return errors.Errorf("max-results must be between 0 and %d, inclusive", maxPageSize)
+	}
+	return nil
+}
+
+// getZones returns the zones of the region, if the
+// Options.Zones were given those are used instead
 func (r *GCPReader) getZones() ([]string, error) {
 	if len(r.zones) > 0 {
 		return r.zones, nil
@@ -90,6 +325,68 @@ func (r *GCPReader) getZones() ([]string, error) {
 	return zones, nil
 }
 
+// ListNetworkPeerings returns a list of NetworkPeerings within a project,
+// the key of the map is the network name
+func (r *GCPReader) ListNetworkPeerings(ctx context.Context, filter string) (map[string][]compute.NetworkPeering, error) {
+	networks, err := r.ListNetworks(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make(map[string][]compute.NetworkPeering, len(networks))
+	for _, network := range networks {
+		resources := make([]compute.NetworkPeering, 0, len(network.Peerings))
+		for _, res := range network.Peerings {
+			resources = append(resources, *res)
+		}
+		list[network.Name] = resources
+	}
+
+	return list, nil
+}
+
+// ListPerInstanceConfigs returns a list of PerInstanceConfigs of the
+// InstanceGroupManager igm within a project and the zone
+func (r *GCPReader) ListPerInstanceConfigs(ctx context.Context, zone, igm string) ([]compute.PerInstanceConfig, error) {
+	service := compute.NewInstanceGroupManagersService(r.compute)
+
+	resources := make([]compute.PerInstanceConfig, 0)
+
+	if err := service.ListPerInstanceConfigs(r.project, zone, igm).
+		MaxResults(int64(r.maxResults)).
+		Pages(ctx, func(list *compute.InstanceGroupManagersListPerInstanceConfigsResp) error {
+			for _, res := range list.Items {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrapf(err, "unable to list compute PerInstanceConfig of %s from google APIs", igm)
+	}
+
+	return resources, nil
+}
+
+// ListRegionPerInstanceConfigs returns a list of PerInstanceConfigs of
+// the regional InstanceGroupManager igm within a project and a region
+func (r *GCPReader) ListRegionPerInstanceConfigs(ctx context.Context, igm string) ([]compute.PerInstanceConfig, error) {
+	service := compute.NewRegionInstanceGroupManagersService(r.compute)
+
+	resources := make([]compute.PerInstanceConfig, 0)
+
+	if err := service.ListPerInstanceConfigs(r.project, r.region, igm).
+		MaxResults(int64(r.maxResults)).
+		Pages(ctx, func(list *compute.RegionInstanceGroupManagersListInstanceConfigsResp) error {
+			for _, res := range list.Items {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrapf(err, "unable to list compute PerInstanceConfig of %s from google APIs", igm)
+	}
+
+	return resources, nil
+}
+
 // ListResourceRecordSets returns a list of ResourceRecordSets within a project and a zone
 func (r *GCPReader) ListResourceRecordSets(ctx context.Context, managedZone []string) (map[string][]dns.ResourceRecordSet, error) {
 	service := dns.NewResourceRecordSetsService(r.dns)
@@ -139,3 +436,721 @@ func (r *GCPReader) ListProjectIAMCustomRoles(ctx context.Context, parent string
 
 	return resources, nil
 }
+
+// ListCloudSchedulerJobs returns a list of Cloud Scheduler Jobs within a project and a location
+func (r *GCPReader) ListCloudSchedulerJobs(ctx context.Context, location string) ([]cloudscheduler.Job, error) {
+	service := cloudscheduler.NewProjectsLocationsJobsService(r.cloudscheduler)
+
+	resources := make([]cloudscheduler.Job, 0)
+
+	if err := service.List(fmt.Sprintf("projects/%s/locations/%s", r.project, location)).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *cloudscheduler.ListJobsResponse) error {
+			for _, res := range list.Jobs {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrapf(err, "unable to list cloudscheduler Job from location %s", location)
+	}
+
+	return resources, nil
+}
+
+// ListCloudTasksQueues returns a list of Cloud Tasks Queues within a project and a location
+func (r *GCPReader) ListCloudTasksQueues(ctx context.Context, location string) ([]cloudtasks.Queue, error) {
+	service := cloudtasks.NewProjectsLocationsQueuesService(r.cloudtasks)
+
+	resources := make([]cloudtasks.Queue, 0)
+
+	if err := service.List(fmt.Sprintf("projects/%s/locations/%s", r.project, location)).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *cloudtasks.ListQueuesResponse) error {
+			for _, res := range list.Queues {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrapf(err, "unable to list cloudtasks Queue from location %s", location)
+	}
+
+	return resources, nil
+}
+
+// ListWorkflows returns a list of Workflows within a project and a location
+func (r *GCPReader) ListWorkflows(ctx context.Context, location string) ([]workflows.Workflow, error) {
+	service := workflows.NewProjectsLocationsWorkflowsService(r.workflows)
+
+	resources := make([]workflows.Workflow, 0)
+
+	if err := service.List(fmt.Sprintf("projects/%s/locations/%s", r.project, location)).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *workflows.ListWorkflowsResponse) error {
+			for _, res := range list.Workflows {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrapf(err, "unable to list workflows Workflow from location %s", location)
+	}
+
+	return resources, nil
+}
+
+// ListEventarcTriggers returns a list of Eventarc Triggers within a project and a location
+func (r *GCPReader) ListEventarcTriggers(ctx context.Context, location string) ([]eventarc.Trigger, error) {
+	service := eventarc.NewProjectsLocationsTriggersService(r.eventarc)
+
+	resources := make([]eventarc.Trigger, 0)
+
+	if err := service.List(fmt.Sprintf("projects/%s/locations/%s", r.project, location)).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *eventarc.ListTriggersResponse) error {
+			for _, res := range list.Triggers {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrapf(err, "unable to list eventarc Trigger from location %s", location)
+	}
+
+	return resources, nil
+}
+
+// ListOSConfigPatchDeployments returns a list of OS Config PatchDeployments within a project
+func (r *GCPReader) ListOSConfigPatchDeployments(ctx context.Context) ([]osconfig.PatchDeployment, error) {
+	service := osconfig.NewProjectsPatchDeploymentsService(r.osconfig)
+
+	resources := make([]osconfig.PatchDeployment, 0)
+
+	if err := service.List(fmt.Sprintf("projects/%s", r.project)).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *osconfig.ListPatchDeploymentsResponse) error {
+			for _, res := range list.PatchDeployments {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrap(err, "unable to list osconfig PatchDeployment from google APIs")
+	}
+
+	return resources, nil
+}
+
+// ListComposerEnvironments returns a list of Composer Environments within a project and a location
+func (r *GCPReader) ListComposerEnvironments(ctx context.Context, location string) ([]composer.Environment, error) {
+	service := composer.NewProjectsLocationsEnvironmentsService(r.composer)
+
+	resources := make([]composer.Environment, 0)
+
+	if err := service.List(fmt.Sprintf("projects/%s/locations/%s", r.project, location)).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *composer.ListEnvironmentsResponse) error {
+			for _, res := range list.Environments {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrapf(err, "unable to list composer Environment from location %s", location)
+	}
+
+	return resources, nil
+}
+
+// ListFilestoreInstances returns a list of Filestore Instances within a project and a location
+func (r *GCPReader) ListFilestoreInstances(ctx context.Context, location, filter string) ([]file.Instance, error) {
+	service := file.NewProjectsLocationsInstancesService(r.file)
+
+	resources := make([]file.Instance, 0)
+
+	if err := service.List(fmt.Sprintf("projects/%s/locations/%s", r.project, location)).
+		Filter(filter).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *file.ListInstancesResponse) error {
+			for _, res := range list.Instances {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrapf(err, "unable to list file Instance from location %s", location)
+	}
+
+	return resources, nil
+}
+
+// ListPubSubTopics returns a list of Pub/Sub Topics within a project
+func (r *GCPReader) ListPubSubTopics(ctx context.Context) ([]pubsub.Topic, error) {
+	service := pubsub.NewProjectsTopicsService(r.pubsub)
+
+	resources := make([]pubsub.Topic, 0)
+
+	if err := service.List(fmt.Sprintf("projects/%s", r.project)).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *pubsub.ListTopicsResponse) error {
+			for _, res := range list.Topics {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrap(err, "unable to list pubsub Topic from google APIs")
+	}
+
+	return resources, nil
+}
+
+// ListLoggingSinks returns a list of Logging Sinks within a project
+func (r *GCPReader) ListLoggingSinks(ctx context.Context) ([]logging.LogSink, error) {
+	service := logging.NewProjectsSinksService(r.logging)
+
+	resources := make([]logging.LogSink, 0)
+
+	if err := service.List(fmt.Sprintf("projects/%s", r.project)).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *logging.ListSinksResponse) error {
+			for _, res := range list.Sinks {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrap(err, "unable to list logging LogSink from google APIs")
+	}
+
+	return resources, nil
+}
+
+// ListLoggingMetrics returns a list of Logging Metrics within a project
+func (r *GCPReader) ListLoggingMetrics(ctx context.Context) ([]logging.LogMetric, error) {
+	service := logging.NewProjectsMetricsService(r.logging)
+
+	resources := make([]logging.LogMetric, 0)
+
+	if err := service.List(fmt.Sprintf("projects/%s", r.project)).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *logging.ListLogMetricsResponse) error {
+			for _, res := range list.Metrics {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrap(err, "unable to list logging LogMetric from google APIs")
+	}
+
+	return resources, nil
+}
+
+// ListMonitoringAlertPolicies returns a list of Monitoring Alert Policies within a project
+func (r *GCPReader) ListMonitoringAlertPolicies(ctx context.Context, filter string) ([]monitoring.AlertPolicy, error) {
+	service := monitoring.NewProjectsAlertPoliciesService(r.monitoring)
+
+	resources := make([]monitoring.AlertPolicy, 0)
+
+	if err := service.List(fmt.Sprintf("projects/%s", r.project)).
+		Filter(filter).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *monitoring.ListAlertPoliciesResponse) error {
+			for _, res := range list.AlertPolicies {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrap(err, "unable to list monitoring AlertPolicy from google APIs")
+	}
+
+	return resources, nil
+}
+
+// ListMonitoringNotificationChannels returns a list of Monitoring Notification Channels within a project
+func (r *GCPReader) ListMonitoringNotificationChannels(ctx context.Context, filter string) ([]monitoring.NotificationChannel, error) {
+	service := monitoring.NewProjectsNotificationChannelsService(r.monitoring)
+
+	resources := make([]monitoring.NotificationChannel, 0)
+
+	if err := service.List(fmt.Sprintf("projects/%s", r.project)).
+		Filter(filter).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *monitoring.ListNotificationChannelsResponse) error {
+			for _, res := range list.NotificationChannels {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrap(err, "unable to list monitoring NotificationChannel from google APIs")
+	}
+
+	return resources, nil
+}
+
+// ListDataprocClusters returns a list of Dataproc Clusters within a project and a region
+func (r *GCPReader) ListDataprocClusters(ctx context.Context, region, filter string) ([]dataproc.Cluster, error) {
+	service := dataproc.NewProjectsRegionsClustersService(r.dataproc)
+
+	resources := make([]dataproc.Cluster, 0)
+
+	if err := service.List(r.project, region).
+		Filter(filter).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *dataproc.ListClustersResponse) error {
+			for _, res := range list.Clusters {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrapf(err, "unable to list dataproc Cluster from region %s", region)
+	}
+
+	return resources, nil
+}
+
+// ListIAPBrands returns a list of IAP Brands within a project
+func (r *GCPReader) ListIAPBrands(ctx context.Context) ([]iap.Brand, error) {
+	service := iap.NewProjectsBrandsService(r.iap)
+
+	// The Brands are not paginated
+	list, err := service.List(fmt.Sprintf("projects/%s", r.project)).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list iap Brand from google APIs")
+	}
+
+	resources := make([]iap.Brand, 0, len(list.Brands))
+	for _, res := range list.Brands {
+		resources = append(resources, *res)
+	}
+
+	return resources, nil
+}
+
+// GetAppEngineApplication returns the App Engine Application of the project
+func (r *GCPReader) GetAppEngineApplication(ctx context.Context) (*appengine.Application, error) {
+	service := appengine.NewAppsService(r.appengine)
+
+	app, err := service.Get(r.project).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get appengine Application from google APIs")
+	}
+
+	return app, nil
+}
+
+// GetBinaryAuthorizationPolicy returns the Binary Authorization Policy of the project
+func (r *GCPReader) GetBinaryAuthorizationPolicy(ctx context.Context) (*binaryauthorization.Policy, error) {
+	service := binaryauthorization.NewProjectsService(r.binauthz)
+
+	policy, err := service.GetPolicy(fmt.Sprintf("projects/%s/policy", r.project)).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get binaryauthorization Policy from google APIs")
+	}
+
+	return policy, nil
+}
+
+// ListBinaryAuthorizationAttestors returns a list of Binary Authorization Attestors within a project
+func (r *GCPReader) ListBinaryAuthorizationAttestors(ctx context.Context) ([]binaryauthorization.Attestor, error) {
+	service := binaryauthorization.NewProjectsAttestorsService(r.binauthz)
+
+	resources := make([]binaryauthorization.Attestor, 0)
+
+	if err := service.List(fmt.Sprintf("projects/%s", r.project)).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *binaryauthorization.ListAttestorsResponse) error {
+			for _, res := range list.Attestors {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrap(err, "unable to list binaryauthorization Attestor from google APIs")
+	}
+
+	return resources, nil
+}
+
+// GetBillingAccount returns the Billing Account with the id
+func (r *GCPReader) GetBillingAccount(ctx context.Context, id string) (*cloudbilling.BillingAccount, error) {
+	service := cloudbilling.NewBillingAccountsService(r.cloudbilling)
+
+	account, err := service.Get(fmt.Sprintf("billingAccounts/%s", id)).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get cloudbilling BillingAccount from google APIs")
+	}
+
+	return account, nil
+}
+
+// GetComputeProject returns the Compute configuration of the project, with
+// its common metadata and default network tier. The result is cached
+// so it's only requested once
+func (r *GCPReader) GetComputeProject(ctx context.Context) (*compute.Project, error) {
+	project, err := r.lists.do("GetComputeProject", func() (interface{}, error) {
+		service := compute.NewProjectsService(r.compute)
+
+		project, err := service.Get(r.project).Context(ctx).Do()
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get compute Project from google APIs")
+		}
+		return project, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return project.(*compute.Project), nil
+}
+
+// ListAppEngineServices returns a list of App Engine Services within a project
+func (r *GCPReader) ListAppEngineServices(ctx context.Context) ([]appengine.Service, error) {
+	service := appengine.NewAppsServicesService(r.appengine)
+
+	resources := make([]appengine.Service, 0)
+
+	if err := service.List(r.project).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *appengine.ListServicesResponse) error {
+			for _, res := range list.Services {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrap(err, "unable to list appengine Service from google APIs")
+	}
+
+	return resources, nil
+}
+
+// ListAppEngineVersions returns a list of App Engine Versions of
+// each service, the key of the map is the service ID
+func (r *GCPReader) ListAppEngineVersions(ctx context.Context, services []string) (map[string][]appengine.Version, error) {
+	service := appengine.NewAppsServicesVersionsService(r.appengine)
+
+	list := make(map[string][]appengine.Version, len(services))
+
+	for _, s := range services {
+		resources := make([]appengine.Version, 0)
+		if err := service.List(r.project, s).
+			PageSize(int64(r.maxResults)).
+			Pages(ctx, func(list *appengine.ListVersionsResponse) error {
+				for _, res := range list.Versions {
+					resources = append(resources, *res)
+				}
+				return nil
+			}); err != nil {
+			return nil, errors.Wrapf(err, "unable to list appengine Version of %s from google APIs", s)
+		}
+		list[s] = resources
+	}
+
+	return list, nil
+}
+
+// ListBigtableInstances returns a list of Bigtable Instances within a project
+func (r *GCPReader) ListBigtableInstances(ctx context.Context) ([]bigtableadmin.Instance, error) {
+	service := bigtableadmin.NewProjectsInstancesService(r.bigtableadmin)
+
+	resources := make([]bigtableadmin.Instance, 0)
+
+	// The Instances do not accept a page size
+	if err := service.List(fmt.Sprintf("projects/%s", r.project)).
+		Pages(ctx, func(list *bigtableadmin.ListInstancesResponse) error {
+			for _, res := range list.Instances {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrap(err, "unable to list bigtableadmin Instance from google APIs")
+	}
+
+	return resources, nil
+}
+
+// ListBigtableTables returns a list of Bigtable Tables of each
+// instance, the key of the map is the instance name
+func (r *GCPReader) ListBigtableTables(ctx context.Context, instances []string) (map[string][]bigtableadmin.Table, error) {
+	service := bigtableadmin.NewProjectsInstancesTablesService(r.bigtableadmin)
+
+	list := make(map[string][]bigtableadmin.Table, len(instances))
+
+	for _, instance := range instances {
+		resources := make([]bigtableadmin.Table, 0)
+		if err := service.List(instance).
+			View("NAME_ONLY").
+			PageSize(int64(r.maxResults)).
+			Pages(ctx, func(list *bigtableadmin.ListTablesResponse) error {
+				for _, res := range list.Tables {
+					resources = append(resources, *res)
+				}
+				return nil
+			}); err != nil {
+			return nil, errors.Wrapf(err, "unable to list bigtableadmin Table of %s from google APIs", instance)
+		}
+		list[instance] = resources
+	}
+
+	return list, nil
+}
+
+// ListCloudIdentityGroups returns a list of Cloud Identity Groups within the customer
+func (r *GCPReader) ListCloudIdentityGroups(ctx context.Context, customer string) ([]cloudidentity.Group, error) {
+	service := cloudidentity.NewGroupsService(r.cloudidentity)
+
+	resources := make([]cloudidentity.Group, 0)
+
+	if err := service.List().
+		Parent(fmt.Sprintf("customers/%s", customer)).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *cloudidentity.ListGroupsResponse) error {
+			for _, res := range list.Groups {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrap(err, "unable to list cloudidentity Group from google APIs")
+	}
+
+	return resources, nil
+}
+
+// ListCloudIdentityGroupMemberships returns a list of Cloud Identity Memberships
+// of each group, the key of the map is the group name
+func (r *GCPReader) ListCloudIdentityGroupMemberships(ctx context.Context, groups []string) (map[string][]cloudidentity.Membership, error) {
+	service := cloudidentity.NewGroupsMembershipsService(r.cloudidentity)
+
+	resources := make(map[string][]cloudidentity.Membership, len(groups))
+
+	for _, group := range groups {
+		memberships := make([]cloudidentity.Membership, 0)
+		if err := service.List(group).
+			PageSize(int64(r.maxResults)).
+			Pages(ctx, func(list *cloudidentity.ListMembershipsResponse) error {
+				for _, res := range list.Memberships {
+					memberships = append(memberships, *res)
+				}
+				return nil
+			}); err != nil {
+			return nil, errors.Wrapf(err, "unable to list cloudidentity Membership of %s from google APIs", group)
+		}
+		resources[group] = memberships
+	}
+
+	return resources, nil
+}
+
+// ListStorageTransferJobs returns a list of Storage Transfer Jobs within a project
+func (r *GCPReader) ListStorageTransferJobs(ctx context.Context) ([]storagetransfer.TransferJob, error) {
+	service := storagetransfer.NewTransferJobsService(r.transfer)
+
+	resources := make([]storagetransfer.TransferJob, 0)
+
+	// The filter is required and it's a JSON with the project
+	filter, err := json.Marshal(map[string]string{"projectId": r.project})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to build the storagetransfer TransferJob filter")
+	}
+
+	if err := service.List(string(filter)).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *storagetransfer.ListTransferJobsResponse) error {
+			for _, res := range list.TransferJobs {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrap(err, "unable to list storagetransfer TransferJob from google APIs")
+	}
+
+	return resources, nil
+}
+
+// GetProjectNumber returns the number of the project, which is used
+// instead of its ID on the full resource names of some APIs. The
+// result is cached so it's only requested once
+func (r *GCPReader) GetProjectNumber(ctx context.Context) (int64, error) {
+	number, err := r.lists.do("GetProjectNumber", func() (interface{}, error) {
+		service := crmv1.NewProjectsService(r.projects)
+
+		project, err := service.Get(r.project).Context(ctx).Do()
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get cloudresourcemanager Project from google APIs")
+		}
+		return project.ProjectNumber, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return number.(int64), nil
+}
+
+// ListProjects returns the active projects
+// the credentials have access to
+func (r *GCPReader) ListProjects(ctx context.Context) ([]crmv1.Project, error) {
+	service := crmv1.NewProjectsService(r.projects)
+
+	resources := make([]crmv1.Project, 0)
+
+	if err := service.List().
+		Filter("lifecycleState:ACTIVE").
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *crmv1.ListProjectsResponse) error {
+			for _, res := range list.Projects {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrap(err, "unable to list cloudresourcemanager Project from google APIs")
+	}
+
+	return resources, nil
+}
+
+// TestProjectPermissions returns which of the permissions
+// the credentials have on the project, requested by batches
+// of maxTestPermissions as it's the limit of the API. At least
+// one request is done so the credentials are always checked
+func (r *GCPReader) TestProjectPermissions(ctx context.Context, permissions []string) ([]string, error) {
+	service := crmv1.NewProjectsService(r.projects)
+
+	granted := make([]string, 0, len(permissions))
+
+	for i := 0; i == 0 || i < len(permissions); i += maxTestPermissions {
+		end := i + maxTestPermissions
+		if end > len(permissions) {
+			end = len(permissions)
+		}
+		res, err := service.TestIamPermissions(r.project, &crmv1.TestIamPermissionsRequest{
+			Permissions: permissions[i:end],
+		}).Context(ctx).Do()
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to test cloudresourcemanager Project permissions from google APIs")
+		}
+		granted = append(granted, res.Permissions...)
+	}
+
+	return granted, nil
+}
+
+// ListTagKeys returns a list of TagKeys within the parent,
+// an organization (ex: organizations/123)
+func (r *GCPReader) ListTagKeys(ctx context.Context, parent string) ([]cloudresourcemanager.TagKey, error) {
+	service := cloudresourcemanager.NewTagKeysService(r.tags)
+
+	resources := make([]cloudresourcemanager.TagKey, 0)
+
+	if err := service.List().
+		Parent(parent).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *cloudresourcemanager.ListTagKeysResponse) error {
+			for _, res := range list.TagKeys {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrap(err, "unable to list cloudresourcemanager TagKey from google APIs")
+	}
+
+	return resources, nil
+}
+
+// ListTagValues returns a list of TagValues of the
+// parent TagKey (ex: tagKeys/123)
+func (r *GCPReader) ListTagValues(ctx context.Context, parent string) ([]cloudresourcemanager.TagValue, error) {
+	service := cloudresourcemanager.NewTagValuesService(r.tags)
+
+	resources := make([]cloudresourcemanager.TagValue, 0)
+
+	if err := service.List().
+		Parent(parent).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *cloudresourcemanager.ListTagValuesResponse) error {
+			for _, res := range list.TagValues {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrapf(err, "unable to list cloudresourcemanager TagValue of %s from google APIs", parent)
+	}
+
+	return resources, nil
+}
+
+// ListTagBindings returns a list of TagBindings attached to the parent,
+// the full resource name of a resource
+// (ex: //cloudresourcemanager.googleapis.com/projects/123)
+func (r *GCPReader) ListTagBindings(ctx context.Context, parent string) ([]cloudresourcemanager.TagBinding, error) {
+	service := cloudresourcemanager.NewTagBindingsService(r.tags)
+
+	resources := make([]cloudresourcemanager.TagBinding, 0)
+
+	if err := service.List().
+		Parent(parent).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *cloudresourcemanager.ListTagBindingsResponse) error {
+			for _, res := range list.TagBindings {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrap(err, "unable to list cloudresourcemanager TagBinding from google APIs")
+	}
+
+	return resources, nil
+}
+
+// ListAccessPolicies returns a list of AccessPolicies within
+// the parent, an organization (ex: organizations/123)
+func (r *GCPReader) ListAccessPolicies(ctx context.Context, parent string) ([]accesscontextmanager.AccessPolicy, error) {
+	service := accesscontextmanager.NewAccessPoliciesService(r.accesscontext)
+
+	resources := make([]accesscontextmanager.AccessPolicy, 0)
+
+	if err := service.List().
+		Parent(parent).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *accesscontextmanager.ListAccessPoliciesResponse) error {
+			for _, res := range list.AccessPolicies {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrap(err, "unable to list accesscontextmanager AccessPolicy from google APIs")
+	}
+
+	return resources, nil
+}
+
+// ListAccessLevels returns a list of AccessLevels of the
+// parent AccessPolicy (ex: accessPolicies/123)
+func (r *GCPReader) ListAccessLevels(ctx context.Context, parent string) ([]accesscontextmanager.AccessLevel, error) {
+	service := accesscontextmanager.NewAccessPoliciesAccessLevelsService(r.accesscontext)
+
+	resources := make([]accesscontextmanager.AccessLevel, 0)
+
+	if err := service.List(parent).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *accesscontextmanager.ListAccessLevelsResponse) error {
+			for _, res := range list.AccessLevels {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrapf(err, "unable to list accesscontextmanager AccessLevel of %s from google APIs", parent)
+	}
+
+	return resources, nil
+}
+
+// ListServicePerimeters returns a list of ServicePerimeters
+// of the parent AccessPolicy (ex: accessPolicies/123)
+func (r *GCPReader) ListServicePerimeters(ctx context.Context, parent string) ([]accesscontextmanager.ServicePerimeter, error) {
+	service := accesscontextmanager.NewAccessPoliciesServicePerimetersService(r.accesscontext)
+
+	resources := make([]accesscontextmanager.ServicePerimeter, 0)
+
+	if err := service.List(parent).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *accesscontextmanager.ListServicePerimetersResponse) error {
+			for _, res := range list.ServicePerimeters {
+				resources = append(resources, *res)
+			}
+			return nil
+		}); err != nil {
+		return nil, errors.Wrapf(err, "unable to list accesscontextmanager ServicePerimeter of %s from google APIs", parent)
+	}
+
+	return resources, nil
+}
@@ -3,6 +3,7 @@ package google
 // Code generated by 'go generate'; DO NOT EDIT
 import (
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
 
@@ -13,20 +14,40 @@ import (
 )
 
 // ListBackendServices returns a list of BackendServices within a project
+// The result is cached so the same call is only requested once
 func (r *GCPReader) ListBackendServices(ctx context.Context, filter string) ([]compute.BackendService, error) {
+	list, err := r.lists.do("ListBackendServices/"+filter, func() (interface{}, error) {
+		return r.listBackendServices(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.BackendService), nil
+}
+
+func (r *GCPReader) listBackendServices(ctx context.Context, filter string) ([]compute.BackendService, error) {
 	service := compute.NewBackendServicesService(r.compute)
 
 	resources := make([]compute.BackendService, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
 
-	if err := service.List(r.project).
-		Filter(filter).
-		MaxResults(int64(r.maxResults)).
-		Pages(ctx, func(list *compute.BackendServiceList) error {
-			for _, res := range list.Items {
-				resources = append(resources, *res)
-			}
-			return nil
-		}); err != nil {
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name,enableCDN,cdnPolicy)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
 		return nil, errors.Wrap(err, "unable to list compute BackendService from google APIs")
 	}
 
@@ -35,20 +56,40 @@ func (r *GCPReader) ListBackendServices(ctx context.Context, filter string) ([]c
 }
 
 // ListBackendBuckets returns a list of BackendBuckets within a project
+// The result is cached so the same call is only requested once
 func (r *GCPReader) ListBackendBuckets(ctx context.Context, filter string) ([]compute.BackendBucket, error) {
+	list, err := r.lists.do("ListBackendBuckets/"+filter, func() (interface{}, error) {
+		return r.listBackendBuckets(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.BackendBucket), nil
+}
+
+func (r *GCPReader) listBackendBuckets(ctx context.Context, filter string) ([]compute.BackendBucket, error) {
 	service := compute.NewBackendBucketsService(r.compute)
 
 	resources := make([]compute.BackendBucket, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
 
-	if err := service.List(r.project).
-		Filter(filter).
-		MaxResults(int64(r.maxResults)).
-		Pages(ctx, func(list *compute.BackendBucketList) error {
-			for _, res := range list.Items {
-				resources = append(resources, *res)
-			}
-			return nil
-		}); err != nil {
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name,enableCdn,cdnPolicy)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
 		return nil, errors.Wrap(err, "unable to list compute BackendBucket from google APIs")
 	}
 
@@ -57,19 +98,39 @@ func (r *GCPReader) ListBackendBuckets(ctx context.Context, filter string) ([]co
 }
 
 // ListBuckets returns a list of Buckets within a project
+// The result is cached so the same call is only requested once
 func (r *GCPReader) ListBuckets(ctx context.Context) ([]storage.Bucket, error) {
+	list, err := r.lists.do("ListBuckets", func() (interface{}, error) {
+		return r.listBuckets(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]storage.Bucket), nil
+}
+
+func (r *GCPReader) listBuckets(ctx context.Context) ([]storage.Bucket, error) {
 	service := storage.NewBucketsService(r.storage)
 
 	resources := make([]storage.Bucket, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
 
-	if err := service.List(r.project).
-		MaxResults(int64(r.maxResults)).
-		Pages(ctx, func(list *storage.Buckets) error {
-			for _, res := range list.Items {
-				resources = append(resources, *res)
-			}
-			return nil
-		}); err != nil {
+		page, err := service.List(r.project).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
 		return nil, errors.Wrap(err, "unable to list storage Bucket from google APIs")
 	}
 
@@ -78,20 +139,40 @@ func (r *GCPReader) ListBuckets(ctx context.Context) ([]storage.Bucket, error) {
 }
 
 // ListStorageInstances returns a list of StorageInstances within a project
+// The result is cached so the same call is only requested once
 func (r *GCPReader) ListStorageInstances(ctx context.Context, filter string) ([]sqladmin.DatabaseInstance, error) {
+	list, err := r.lists.do("ListStorageInstances/"+filter, func() (interface{}, error) {
+		return r.listStorageInstances(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]sqladmin.DatabaseInstance), nil
+}
+
+func (r *GCPReader) listStorageInstances(ctx context.Context, filter string) ([]sqladmin.DatabaseInstance, error) {
 	service := sqladmin.NewInstancesService(r.sqladmin)
 
 	resources := make([]sqladmin.DatabaseInstance, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
 
-	if err := service.List(r.project).
-		Filter(filter).
-		MaxResults(int64(r.maxResults)).
-		Pages(ctx, func(list *sqladmin.InstancesListResponse) error {
-			for _, res := range list.Items {
-				resources = append(resources, *res)
-			}
-			return nil
-		}); err != nil {
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name,state)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
 		return nil, errors.Wrap(err, "unable to list sqladmin DatabaseInstance from google APIs")
 	}
 
@@ -100,51 +181,141 @@ func (r *GCPReader) ListStorageInstances(ctx context.Context, filter string) ([]
 }
 
 // ListDisks returns a list of Disks within a project and a zone
+// The result is cached so the same call is only requested once
 func (r *GCPReader) ListDisks(ctx context.Context, filter string) (map[string][]compute.Disk, error) {
+	list, err := r.lists.do("ListDisks/"+filter, func() (interface{}, error) {
+		return r.listDisks(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(map[string][]compute.Disk), nil
+}
+
+func (r *GCPReader) listDisks(ctx context.Context, filter string) (map[string][]compute.Disk, error) {
 	service := compute.NewDisksService(r.compute)
 
+	var mu sync.Mutex
 	list := make(map[string][]compute.Disk)
 	zones, err := r.getZones()
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to get zones in region")
 	}
-	for _, zone := range zones {
+	err = parallel(ctx, len(zones), func(ctx context.Context, i int) error {
+		zone := zones[i]
 
 		resources := make([]compute.Disk, 0)
-
-		if err := service.List(r.project, zone).
-			Filter(filter).
-			MaxResults(int64(r.maxResults)).
-			Pages(ctx, func(list *compute.DiskList) error {
-				for _, res := range list.Items {
-					resources = append(resources, *res)
-				}
-				return nil
-			}); err != nil {
-			return nil, errors.Wrap(err, "unable to list compute Disk from google APIs")
+		// The pages are requested one by one so if one
+		// fails it's retried without losing the previous ones
+		if err := pages(ctx, func(token string) (string, error) {
+
+			page, err := service.List(r.project, zone).
+				Filter(filter).
+				Fields("nextPageToken", "items(name,status,resourcePolicies,sourceImage,sourceSnapshot)").
+				MaxResults(int64(r.maxResults)).
+				PageToken(token).
+				Context(ctx).
+				Do()
+			if err != nil {
+				return "", err
+			}
+			for _, res := range page.Items {
+				resources = append(resources, *res)
+			}
+			return page.NextPageToken, nil
+		}); err != nil {
+			return errors.Wrap(err, "unable to list compute Disk from google APIs")
 		}
 
+		mu.Lock()
 		list[zone] = resources
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return list, nil
 
 }
 
+// ListExternalVPNGateways returns a list of ExternalVPNGateways within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListExternalVPNGateways(ctx context.Context, filter string) ([]compute.ExternalVpnGateway, error) {
+	list, err := r.lists.do("ListExternalVPNGateways/"+filter, func() (interface{}, error) {
+		return r.listExternalVPNGateways(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.ExternalVpnGateway), nil
+}
+
+func (r *GCPReader) listExternalVPNGateways(ctx context.Context, filter string) ([]compute.ExternalVpnGateway, error) {
+	service := compute.NewExternalVpnGatewaysService(r.compute)
+
+	resources := make([]compute.ExternalVpnGateway, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute ExternalVpnGateway from google APIs")
+	}
+
+	return resources, nil
+
+}
+
 // ListFirewalls returns a list of Firewalls within a project
+// The result is cached so the same call is only requested once
 func (r *GCPReader) ListFirewalls(ctx context.Context, filter string) ([]compute.Firewall, error) {
+	list, err := r.lists.do("ListFirewalls/"+filter, func() (interface{}, error) {
+		return r.listFirewalls(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.Firewall), nil
+}
+
+func (r *GCPReader) listFirewalls(ctx context.Context, filter string) ([]compute.Firewall, error) {
 	service := compute.NewFirewallsService(r.compute)
 
 	resources := make([]compute.Firewall, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
 
-	if err := service.List(r.project).
-		Filter(filter).
-		MaxResults(int64(r.maxResults)).
-		Pages(ctx, func(list *compute.FirewallList) error {
-			for _, res := range list.Items {
-				resources = append(resources, *res)
-			}
-			return nil
-		}); err != nil {
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name,allowed,denied,direction,priority,sourceRanges,destinationRanges,sourceTags,targetTags,sourceServiceAccounts,targetServiceAccounts)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
 		return nil, errors.Wrap(err, "unable to list compute Firewall from google APIs")
 	}
 
@@ -153,20 +324,40 @@ func (r *GCPReader) ListFirewalls(ctx context.Context, filter string) ([]compute
 }
 
 // ListGlobalForwardingRules returns a list of GlobalForwardingRules within a project
+// The result is cached so the same call is only requested once
 func (r *GCPReader) ListGlobalForwardingRules(ctx context.Context, filter string) ([]compute.ForwardingRule, error) {
+	list, err := r.lists.do("ListGlobalForwardingRules/"+filter, func() (interface{}, error) {
+		return r.listGlobalForwardingRules(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.ForwardingRule), nil
+}
+
+func (r *GCPReader) listGlobalForwardingRules(ctx context.Context, filter string) ([]compute.ForwardingRule, error) {
 	service := compute.NewGlobalForwardingRulesService(r.compute)
 
 	resources := make([]compute.ForwardingRule, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
 
-	if err := service.List(r.project).
-		Filter(filter).
-		MaxResults(int64(r.maxResults)).
-		Pages(ctx, func(list *compute.ForwardingRuleList) error {
-			for _, res := range list.Items {
-				resources = append(resources, *res)
-			}
-			return nil
-		}); err != nil {
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name,network,target,IPProtocol,portRange)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
 		return nil, errors.Wrap(err, "unable to list compute ForwardingRule from google APIs")
 	}
 
@@ -175,20 +366,40 @@ func (r *GCPReader) ListGlobalForwardingRules(ctx context.Context, filter string
 }
 
 // ListForwardingRules returns a list of ForwardingRules within a project
+// The result is cached so the same call is only requested once
 func (r *GCPReader) ListForwardingRules(ctx context.Context, filter string) ([]compute.ForwardingRule, error) {
+	list, err := r.lists.do("ListForwardingRules/"+filter, func() (interface{}, error) {
+		return r.listForwardingRules(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.ForwardingRule), nil
+}
+
+func (r *GCPReader) listForwardingRules(ctx context.Context, filter string) ([]compute.ForwardingRule, error) {
 	service := compute.NewForwardingRulesService(r.compute)
 
 	resources := make([]compute.ForwardingRule, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
 
-	if err := service.List(r.project, r.region).
-		Filter(filter).
-		MaxResults(int64(r.maxResults)).
-		Pages(ctx, func(list *compute.ForwardingRuleList) error {
-			for _, res := range list.Items {
-				resources = append(resources, *res)
-			}
-			return nil
-		}); err != nil {
+		page, err := service.List(r.project, r.region).
+			Filter(filter).
+			Fields("nextPageToken", "items(name,network,target,IPProtocol,portRange)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
 		return nil, errors.Wrap(err, "unable to list compute ForwardingRule from google APIs")
 	}
 
@@ -197,20 +408,82 @@ func (r *GCPReader) ListForwardingRules(ctx context.Context, filter string) ([]c
 }
 
 // ListHealthChecks returns a list of HealthChecks within a project
+// The result is cached so the same call is only requested once
 func (r *GCPReader) ListHealthChecks(ctx context.Context, filter string) ([]compute.HealthCheck, error) {
+	list, err := r.lists.do("ListHealthChecks/"+filter, func() (interface{}, error) {
+		return r.listHealthChecks(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.HealthCheck), nil
+}
+
+func (r *GCPReader) listHealthChecks(ctx context.Context, filter string) ([]compute.HealthCheck, error) {
 	service := compute.NewHealthChecksService(r.compute)
 
 	resources := make([]compute.HealthCheck, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
 
-	if err := service.List(r.project).
-		Filter(filter).
-		MaxResults(int64(r.maxResults)).
-		Pages(ctx, func(list *compute.HealthCheckList) error {
-			for _, res := range list.Items {
-				resources = append(resources, *res)
-			}
-			return nil
-		}); err != nil {
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute HealthCheck from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListRegionHealthChecks returns a list of RegionHealthChecks within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListRegionHealthChecks(ctx context.Context, filter string) ([]compute.HealthCheck, error) {
+	list, err := r.lists.do("ListRegionHealthChecks/"+filter, func() (interface{}, error) {
+		return r.listRegionHealthChecks(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.HealthCheck), nil
+}
+
+func (r *GCPReader) listRegionHealthChecks(ctx context.Context, filter string) ([]compute.HealthCheck, error) {
+	service := compute.NewRegionHealthChecksService(r.compute)
+
+	resources := make([]compute.HealthCheck, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project, r.region).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
 		return nil, errors.Wrap(err, "unable to list compute HealthCheck from google APIs")
 	}
 
@@ -218,171 +491,1191 @@ func (r *GCPReader) ListHealthChecks(ctx context.Context, filter string) ([]comp
 
 }
 
+// ListHTTPHealthChecks returns a list of HTTPHealthChecks within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListHTTPHealthChecks(ctx context.Context, filter string) ([]compute.HttpHealthCheck, error) {
+	list, err := r.lists.do("ListHTTPHealthChecks/"+filter, func() (interface{}, error) {
+		return r.listHTTPHealthChecks(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.HttpHealthCheck), nil
+}
+
+func (r *GCPReader) listHTTPHealthChecks(ctx context.Context, filter string) ([]compute.HttpHealthCheck, error) {
+	service := compute.NewHttpHealthChecksService(r.compute)
+
+	resources := make([]compute.HttpHealthCheck, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute HttpHealthCheck from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListHTTPSHealthChecks returns a list of HTTPSHealthChecks within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListHTTPSHealthChecks(ctx context.Context, filter string) ([]compute.HttpsHealthCheck, error) {
+	list, err := r.lists.do("ListHTTPSHealthChecks/"+filter, func() (interface{}, error) {
+		return r.listHTTPSHealthChecks(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.HttpsHealthCheck), nil
+}
+
+func (r *GCPReader) listHTTPSHealthChecks(ctx context.Context, filter string) ([]compute.HttpsHealthCheck, error) {
+	service := compute.NewHttpsHealthChecksService(r.compute)
+
+	resources := make([]compute.HttpsHealthCheck, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute HttpsHealthCheck from google APIs")
+	}
+
+	return resources, nil
+
+}
+
 // ListInstances returns a list of Instances within a project and a zone
+// The result is cached so the same call is only requested once
 func (r *GCPReader) ListInstances(ctx context.Context, filter string) (map[string][]compute.Instance, error) {
+	list, err := r.lists.do("ListInstances/"+filter, func() (interface{}, error) {
+		return r.listInstances(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(map[string][]compute.Instance), nil
+}
+
+func (r *GCPReader) listInstances(ctx context.Context, filter string) (map[string][]compute.Instance, error) {
 	service := compute.NewInstancesService(r.compute)
 
+	var mu sync.Mutex
 	list := make(map[string][]compute.Instance)
 	zones, err := r.getZones()
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to get zones in region")
 	}
-	for _, zone := range zones {
+	err = parallel(ctx, len(zones), func(ctx context.Context, i int) error {
+		zone := zones[i]
 
 		resources := make([]compute.Instance, 0)
-
-		if err := service.List(r.project, zone).
-			Filter(filter).
-			MaxResults(int64(r.maxResults)).
-			Pages(ctx, func(list *compute.InstanceList) error {
-				for _, res := range list.Items {
-					resources = append(resources, *res)
-				}
-				return nil
-			}); err != nil {
-			return nil, errors.Wrap(err, "unable to list compute Instance from google APIs")
+		// The pages are requested one by one so if one
+		// fails it's retried without losing the previous ones
+		if err := pages(ctx, func(token string) (string, error) {
+
+			page, err := service.List(r.project, zone).
+				Filter(filter).
+				Fields("nextPageToken", "items(name,status,machineType,networkInterfaces(network),disks(boot,source))").
+				MaxResults(int64(r.maxResults)).
+				PageToken(token).
+				Context(ctx).
+				Do()
+			if err != nil {
+				return "", err
+			}
+			for _, res := range page.Items {
+				resources = append(resources, *res)
+			}
+			return page.NextPageToken, nil
+		}); err != nil {
+			return errors.Wrap(err, "unable to list compute Instance from google APIs")
 		}
 
+		mu.Lock()
 		list[zone] = resources
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return list, nil
 
 }
 
 // ListInstanceGroups returns a list of InstanceGroups within a project and a zone
+// The result is cached so the same call is only requested once
 func (r *GCPReader) ListInstanceGroups(ctx context.Context, filter string) (map[string][]compute.InstanceGroup, error) {
+	list, err := r.lists.do("ListInstanceGroups/"+filter, func() (interface{}, error) {
+		return r.listInstanceGroups(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(map[string][]compute.InstanceGroup), nil
+}
+
+func (r *GCPReader) listInstanceGroups(ctx context.Context, filter string) (map[string][]compute.InstanceGroup, error) {
 	service := compute.NewInstanceGroupsService(r.compute)
 
+	var mu sync.Mutex
 	list := make(map[string][]compute.InstanceGroup)
 	zones, err := r.getZones()
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to get zones in region")
 	}
-	for _, zone := range zones {
+	err = parallel(ctx, len(zones), func(ctx context.Context, i int) error {
+		zone := zones[i]
 
 		resources := make([]compute.InstanceGroup, 0)
-
-		if err := service.List(r.project, zone).
-			Filter(filter).
-			MaxResults(int64(r.maxResults)).
-			Pages(ctx, func(list *compute.InstanceGroupList) error {
-				for _, res := range list.Items {
-					resources = append(resources, *res)
-				}
-				return nil
-			}); err != nil {
-			return nil, errors.Wrap(err, "unable to list compute InstanceGroup from google APIs")
+		// The pages are requested one by one so if one
+		// fails it's retried without losing the previous ones
+		if err := pages(ctx, func(token string) (string, error) {
+
+			page, err := service.List(r.project, zone).
+				Filter(filter).
+				Fields("nextPageToken", "items(name,namedPorts)").
+				MaxResults(int64(r.maxResults)).
+				PageToken(token).
+				Context(ctx).
+				Do()
+			if err != nil {
+				return "", err
+			}
+			for _, res := range page.Items {
+				resources = append(resources, *res)
+			}
+			return page.NextPageToken, nil
+		}); err != nil {
+			return errors.Wrap(err, "unable to list compute InstanceGroup from google APIs")
 		}
 
+		mu.Lock()
 		list[zone] = resources
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return list, nil
 
 }
 
-// ListManagedZones returns a list of ManagedZones within a project
-func (r *GCPReader) ListManagedZones(ctx context.Context) ([]dns.ManagedZone, error) {
-	service := dns.NewManagedZonesService(r.dns)
+// ListInstanceGroupManagers returns a list of InstanceGroupManagers within a project and a zone
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListInstanceGroupManagers(ctx context.Context, filter string) (map[string][]compute.InstanceGroupManager, error) {
+	list, err := r.lists.do("ListInstanceGroupManagers/"+filter, func() (interface{}, error) {
+		return r.listInstanceGroupManagers(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(map[string][]compute.InstanceGroupManager), nil
+}
 
-	resources := make([]dns.ManagedZone, 0)
+func (r *GCPReader) listInstanceGroupManagers(ctx context.Context, filter string) (map[string][]compute.InstanceGroupManager, error) {
+	service := compute.NewInstanceGroupManagersService(r.compute)
 
-	if err := service.List(r.project).
-		MaxResults(int64(r.maxResults)).
-		Pages(ctx, func(list *dns.ManagedZonesListResponse) error {
-			for _, res := range list.ManagedZones {
+	var mu sync.Mutex
+	list := make(map[string][]compute.InstanceGroupManager)
+	zones, err := r.getZones()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get zones in region")
+	}
+	err = parallel(ctx, len(zones), func(ctx context.Context, i int) error {
+		zone := zones[i]
+
+		resources := make([]compute.InstanceGroupManager, 0)
+		// The pages are requested one by one so if one
+		// fails it's retried without losing the previous ones
+		if err := pages(ctx, func(token string) (string, error) {
+
+			page, err := service.List(r.project, zone).
+				Filter(filter).
+				MaxResults(int64(r.maxResults)).
+				PageToken(token).
+				Context(ctx).
+				Do()
+			if err != nil {
+				return "", err
+			}
+			for _, res := range page.Items {
 				resources = append(resources, *res)
 			}
-			return nil
+			return page.NextPageToken, nil
 		}); err != nil {
-		return nil, errors.Wrap(err, "unable to list dns ManagedZone from google APIs")
+			return errors.Wrap(err, "unable to list compute InstanceGroupManager from google APIs")
+		}
+
+		mu.Lock()
+		list[zone] = resources
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return list, nil
 
-	return resources, nil
+}
 
+// ListRegionInstanceGroupManagers returns a list of RegionInstanceGroupManagers within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListRegionInstanceGroupManagers(ctx context.Context, filter string) ([]compute.InstanceGroupManager, error) {
+	list, err := r.lists.do("ListRegionInstanceGroupManagers/"+filter, func() (interface{}, error) {
+		return r.listRegionInstanceGroupManagers(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.InstanceGroupManager), nil
 }
 
-// ListNetworks returns a list of Networks within a project
-func (r *GCPReader) ListNetworks(ctx context.Context, filter string) ([]compute.Network, error) {
-	service := compute.NewNetworksService(r.compute)
+func (r *GCPReader) listRegionInstanceGroupManagers(ctx context.Context, filter string) ([]compute.InstanceGroupManager, error) {
+	service := compute.NewRegionInstanceGroupManagersService(r.compute)
 
-	resources := make([]compute.Network, 0)
+	resources := make([]compute.InstanceGroupManager, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
 
-	if err := service.List(r.project).
-		Filter(filter).
-		MaxResults(int64(r.maxResults)).
-		Pages(ctx, func(list *compute.NetworkList) error {
-			for _, res := range list.Items {
-				resources = append(resources, *res)
-			}
-			return nil
-		}); err != nil {
-		return nil, errors.Wrap(err, "unable to list compute Network from google APIs")
+		page, err := service.List(r.project, r.region).
+			Filter(filter).
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute InstanceGroupManager from google APIs")
 	}
 
 	return resources, nil
 
 }
 
-// ListSSLCertificates returns a list of SSLCertificates within a project
-func (r *GCPReader) ListSSLCertificates(ctx context.Context, filter string) ([]compute.SslCertificate, error) {
-	service := compute.NewSslCertificatesService(r.compute)
+// ListInterconnectAttachments returns a list of InterconnectAttachments within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListInterconnectAttachments(ctx context.Context, filter string) ([]compute.InterconnectAttachment, error) {
+	list, err := r.lists.do("ListInterconnectAttachments/"+filter, func() (interface{}, error) {
+		return r.listInterconnectAttachments(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.InterconnectAttachment), nil
+}
 
-	resources := make([]compute.SslCertificate, 0)
+func (r *GCPReader) listInterconnectAttachments(ctx context.Context, filter string) ([]compute.InterconnectAttachment, error) {
+	service := compute.NewInterconnectAttachmentsService(r.compute)
 
-	if err := service.List(r.project).
-		Filter(filter).
-		MaxResults(int64(r.maxResults)).
-		Pages(ctx, func(list *compute.SslCertificateList) error {
-			for _, res := range list.Items {
-				resources = append(resources, *res)
-			}
-			return nil
-		}); err != nil {
-		return nil, errors.Wrap(err, "unable to list compute SslCertificate from google APIs")
+	resources := make([]compute.InterconnectAttachment, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project, r.region).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute InterconnectAttachment from google APIs")
 	}
 
 	return resources, nil
 
 }
 
-// ListTargetHTTPProxies returns a list of TargetHTTPProxies within a project
-func (r *GCPReader) ListTargetHTTPProxies(ctx context.Context, filter string) ([]compute.TargetHttpProxy, error) {
-	service := compute.NewTargetHttpProxiesService(r.compute)
+// ListGlobalNetworkEndpointGroups returns a list of GlobalNetworkEndpointGroups within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListGlobalNetworkEndpointGroups(ctx context.Context, filter string) ([]compute.NetworkEndpointGroup, error) {
+	list, err := r.lists.do("ListGlobalNetworkEndpointGroups/"+filter, func() (interface{}, error) {
+		return r.listGlobalNetworkEndpointGroups(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.NetworkEndpointGroup), nil
+}
 
-	resources := make([]compute.TargetHttpProxy, 0)
+func (r *GCPReader) listGlobalNetworkEndpointGroups(ctx context.Context, filter string) ([]compute.NetworkEndpointGroup, error) {
+	service := compute.NewGlobalNetworkEndpointGroupsService(r.compute)
 
-	if err := service.List(r.project).
-		Filter(filter).
-		MaxResults(int64(r.maxResults)).
-		Pages(ctx, func(list *compute.TargetHttpProxyList) error {
-			for _, res := range list.Items {
-				resources = append(resources, *res)
-			}
-			return nil
-		}); err != nil {
-		return nil, errors.Wrap(err, "unable to list compute TargetHttpProxy from google APIs")
+	resources := make([]compute.NetworkEndpointGroup, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name,network)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute NetworkEndpointGroup from google APIs")
 	}
 
 	return resources, nil
 
 }
 
-// ListTargetHTTPSProxies returns a list of TargetHTTPSProxies within a project
+// ListRegionNetworkEndpointGroups returns a list of RegionNetworkEndpointGroups within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListRegionNetworkEndpointGroups(ctx context.Context, filter string) ([]compute.NetworkEndpointGroup, error) {
+	list, err := r.lists.do("ListRegionNetworkEndpointGroups/"+filter, func() (interface{}, error) {
+		return r.listRegionNetworkEndpointGroups(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.NetworkEndpointGroup), nil
+}
+
+func (r *GCPReader) listRegionNetworkEndpointGroups(ctx context.Context, filter string) ([]compute.NetworkEndpointGroup, error) {
+	service := compute.NewRegionNetworkEndpointGroupsService(r.compute)
+
+	resources := make([]compute.NetworkEndpointGroup, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project, r.region).
+			Filter(filter).
+			Fields("nextPageToken", "items(name,network,networkEndpointType,cloudRun,appEngine,cloudFunction)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute NetworkEndpointGroup from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListManagedZones returns a list of ManagedZones within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListManagedZones(ctx context.Context) ([]dns.ManagedZone, error) {
+	list, err := r.lists.do("ListManagedZones", func() (interface{}, error) {
+		return r.listManagedZones(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]dns.ManagedZone), nil
+}
+
+func (r *GCPReader) listManagedZones(ctx context.Context) ([]dns.ManagedZone, error) {
+	service := dns.NewManagedZonesService(r.dns)
+
+	resources := make([]dns.ManagedZone, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project).
+			Fields("nextPageToken", "managedZones(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.ManagedZones {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list dns ManagedZone from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListNetworks returns a list of Networks within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListNetworks(ctx context.Context, filter string) ([]compute.Network, error) {
+	list, err := r.lists.do("ListNetworks/"+filter, func() (interface{}, error) {
+		return r.listNetworks(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.Network), nil
+}
+
+func (r *GCPReader) listNetworks(ctx context.Context, filter string) ([]compute.Network, error) {
+	service := compute.NewNetworksService(r.compute)
+
+	resources := make([]compute.Network, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name,peerings)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute Network from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListNodeGroups returns a list of NodeGroups within a project and a zone
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListNodeGroups(ctx context.Context, filter string) (map[string][]compute.NodeGroup, error) {
+	list, err := r.lists.do("ListNodeGroups/"+filter, func() (interface{}, error) {
+		return r.listNodeGroups(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(map[string][]compute.NodeGroup), nil
+}
+
+func (r *GCPReader) listNodeGroups(ctx context.Context, filter string) (map[string][]compute.NodeGroup, error) {
+	service := compute.NewNodeGroupsService(r.compute)
+
+	var mu sync.Mutex
+	list := make(map[string][]compute.NodeGroup)
+	zones, err := r.getZones()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get zones in region")
+	}
+	err = parallel(ctx, len(zones), func(ctx context.Context, i int) error {
+		zone := zones[i]
+
+		resources := make([]compute.NodeGroup, 0)
+		// The pages are requested one by one so if one
+		// fails it's retried without losing the previous ones
+		if err := pages(ctx, func(token string) (string, error) {
+
+			page, err := service.List(r.project, zone).
+				Filter(filter).
+				Fields("nextPageToken", "items(name)").
+				MaxResults(int64(r.maxResults)).
+				PageToken(token).
+				Context(ctx).
+				Do()
+			if err != nil {
+				return "", err
+			}
+			for _, res := range page.Items {
+				resources = append(resources, *res)
+			}
+			return page.NextPageToken, nil
+		}); err != nil {
+			return errors.Wrap(err, "unable to list compute NodeGroup from google APIs")
+		}
+
+		mu.Lock()
+		list[zone] = resources
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+
+}
+
+// ListNodeTemplates returns a list of NodeTemplates within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListNodeTemplates(ctx context.Context, filter string) ([]compute.NodeTemplate, error) {
+	list, err := r.lists.do("ListNodeTemplates/"+filter, func() (interface{}, error) {
+		return r.listNodeTemplates(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.NodeTemplate), nil
+}
+
+func (r *GCPReader) listNodeTemplates(ctx context.Context, filter string) ([]compute.NodeTemplate, error) {
+	service := compute.NewNodeTemplatesService(r.compute)
+
+	resources := make([]compute.NodeTemplate, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project, r.region).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute NodeTemplate from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListReservations returns a list of Reservations within a project and a zone
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListReservations(ctx context.Context, filter string) (map[string][]compute.Reservation, error) {
+	list, err := r.lists.do("ListReservations/"+filter, func() (interface{}, error) {
+		return r.listReservations(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(map[string][]compute.Reservation), nil
+}
+
+func (r *GCPReader) listReservations(ctx context.Context, filter string) (map[string][]compute.Reservation, error) {
+	service := compute.NewReservationsService(r.compute)
+
+	var mu sync.Mutex
+	list := make(map[string][]compute.Reservation)
+	zones, err := r.getZones()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get zones in region")
+	}
+	err = parallel(ctx, len(zones), func(ctx context.Context, i int) error {
+		zone := zones[i]
+
+		resources := make([]compute.Reservation, 0)
+		// The pages are requested one by one so if one
+		// fails it's retried without losing the previous ones
+		if err := pages(ctx, func(token string) (string, error) {
+
+			page, err := service.List(r.project, zone).
+				Filter(filter).
+				Fields("nextPageToken", "items(name)").
+				MaxResults(int64(r.maxResults)).
+				PageToken(token).
+				Context(ctx).
+				Do()
+			if err != nil {
+				return "", err
+			}
+			for _, res := range page.Items {
+				resources = append(resources, *res)
+			}
+			return page.NextPageToken, nil
+		}); err != nil {
+			return errors.Wrap(err, "unable to list compute Reservation from google APIs")
+		}
+
+		mu.Lock()
+		list[zone] = resources
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+
+}
+
+// ListResourcePolicies returns a list of ResourcePolicies within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListResourcePolicies(ctx context.Context, filter string) ([]compute.ResourcePolicy, error) {
+	list, err := r.lists.do("ListResourcePolicies/"+filter, func() (interface{}, error) {
+		return r.listResourcePolicies(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.ResourcePolicy), nil
+}
+
+func (r *GCPReader) listResourcePolicies(ctx context.Context, filter string) ([]compute.ResourcePolicy, error) {
+	service := compute.NewResourcePoliciesService(r.compute)
+
+	resources := make([]compute.ResourcePolicy, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project, r.region).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute ResourcePolicy from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListSSLCertificates returns a list of SSLCertificates within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListSSLCertificates(ctx context.Context, filter string) ([]compute.SslCertificate, error) {
+	list, err := r.lists.do("ListSSLCertificates/"+filter, func() (interface{}, error) {
+		return r.listSSLCertificates(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.SslCertificate), nil
+}
+
+func (r *GCPReader) listSSLCertificates(ctx context.Context, filter string) ([]compute.SslCertificate, error) {
+	service := compute.NewSslCertificatesService(r.compute)
+
+	resources := make([]compute.SslCertificate, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute SslCertificate from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListRegionSSLCertificates returns a list of RegionSSLCertificates within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListRegionSSLCertificates(ctx context.Context, filter string) ([]compute.SslCertificate, error) {
+	list, err := r.lists.do("ListRegionSSLCertificates/"+filter, func() (interface{}, error) {
+		return r.listRegionSSLCertificates(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.SslCertificate), nil
+}
+
+func (r *GCPReader) listRegionSSLCertificates(ctx context.Context, filter string) ([]compute.SslCertificate, error) {
+	service := compute.NewRegionSslCertificatesService(r.compute)
+
+	resources := make([]compute.SslCertificate, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project, r.region).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute SslCertificate from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListSSLPolicies returns a list of SSLPolicies within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListSSLPolicies(ctx context.Context, filter string) ([]compute.SslPolicy, error) {
+	list, err := r.lists.do("ListSSLPolicies/"+filter, func() (interface{}, error) {
+		return r.listSSLPolicies(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.SslPolicy), nil
+}
+
+func (r *GCPReader) listSSLPolicies(ctx context.Context, filter string) ([]compute.SslPolicy, error) {
+	service := compute.NewSslPoliciesService(r.compute)
+
+	resources := make([]compute.SslPolicy, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute SslPolicy from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListTargetHTTPProxies returns a list of TargetHTTPProxies within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListTargetHTTPProxies(ctx context.Context, filter string) ([]compute.TargetHttpProxy, error) {
+	list, err := r.lists.do("ListTargetHTTPProxies/"+filter, func() (interface{}, error) {
+		return r.listTargetHTTPProxies(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.TargetHttpProxy), nil
+}
+
+func (r *GCPReader) listTargetHTTPProxies(ctx context.Context, filter string) ([]compute.TargetHttpProxy, error) {
+	service := compute.NewTargetHttpProxiesService(r.compute)
+
+	resources := make([]compute.TargetHttpProxy, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute TargetHttpProxy from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListTargetHTTPSProxies returns a list of TargetHTTPSProxies within a project
+// The result is cached so the same call is only requested once
 func (r *GCPReader) ListTargetHTTPSProxies(ctx context.Context, filter string) ([]compute.TargetHttpsProxy, error) {
+	list, err := r.lists.do("ListTargetHTTPSProxies/"+filter, func() (interface{}, error) {
+		return r.listTargetHTTPSProxies(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.TargetHttpsProxy), nil
+}
+
+func (r *GCPReader) listTargetHTTPSProxies(ctx context.Context, filter string) ([]compute.TargetHttpsProxy, error) {
 	service := compute.NewTargetHttpsProxiesService(r.compute)
 
 	resources := make([]compute.TargetHttpsProxy, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute TargetHttpsProxy from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListRegionTargetHTTPProxies returns a list of RegionTargetHTTPProxies within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListRegionTargetHTTPProxies(ctx context.Context, filter string) ([]compute.TargetHttpProxy, error) {
+	list, err := r.lists.do("ListRegionTargetHTTPProxies/"+filter, func() (interface{}, error) {
+		return r.listRegionTargetHTTPProxies(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.TargetHttpProxy), nil
+}
+
+func (r *GCPReader) listRegionTargetHTTPProxies(ctx context.Context, filter string) ([]compute.TargetHttpProxy, error) {
+	service := compute.NewRegionTargetHttpProxiesService(r.compute)
+
+	resources := make([]compute.TargetHttpProxy, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project, r.region).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute TargetHttpProxy from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListRegionTargetHTTPSProxies returns a list of RegionTargetHTTPSProxies within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListRegionTargetHTTPSProxies(ctx context.Context, filter string) ([]compute.TargetHttpsProxy, error) {
+	list, err := r.lists.do("ListRegionTargetHTTPSProxies/"+filter, func() (interface{}, error) {
+		return r.listRegionTargetHTTPSProxies(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.TargetHttpsProxy), nil
+}
+
+func (r *GCPReader) listRegionTargetHTTPSProxies(ctx context.Context, filter string) ([]compute.TargetHttpsProxy, error) {
+	service := compute.NewRegionTargetHttpsProxiesService(r.compute)
+
+	resources := make([]compute.TargetHttpsProxy, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project, r.region).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute TargetHttpsProxy from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListTargetSSLProxies returns a list of TargetSSLProxies within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListTargetSSLProxies(ctx context.Context, filter string) ([]compute.TargetSslProxy, error) {
+	list, err := r.lists.do("ListTargetSSLProxies/"+filter, func() (interface{}, error) {
+		return r.listTargetSSLProxies(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.TargetSslProxy), nil
+}
+
+func (r *GCPReader) listTargetSSLProxies(ctx context.Context, filter string) ([]compute.TargetSslProxy, error) {
+	service := compute.NewTargetSslProxiesService(r.compute)
+
+	resources := make([]compute.TargetSslProxy, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute TargetSslProxy from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListTargetTCPProxies returns a list of TargetTCPProxies within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListTargetTCPProxies(ctx context.Context, filter string) ([]compute.TargetTcpProxy, error) {
+	list, err := r.lists.do("ListTargetTCPProxies/"+filter, func() (interface{}, error) {
+		return r.listTargetTCPProxies(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.TargetTcpProxy), nil
+}
+
+func (r *GCPReader) listTargetTCPProxies(ctx context.Context, filter string) ([]compute.TargetTcpProxy, error) {
+	service := compute.NewTargetTcpProxiesService(r.compute)
+
+	resources := make([]compute.TargetTcpProxy, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute TargetTcpProxy from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListTargetInstances returns a list of TargetInstances within a project and a zone
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListTargetInstances(ctx context.Context, filter string) (map[string][]compute.TargetInstance, error) {
+	list, err := r.lists.do("ListTargetInstances/"+filter, func() (interface{}, error) {
+		return r.listTargetInstances(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(map[string][]compute.TargetInstance), nil
+}
 
-	if err := service.List(r.project).
-		Filter(filter).
-		MaxResults(int64(r.maxResults)).
-		Pages(ctx, func(list *compute.TargetHttpsProxyList) error {
-			for _, res := range list.Items {
+func (r *GCPReader) listTargetInstances(ctx context.Context, filter string) (map[string][]compute.TargetInstance, error) {
+	service := compute.NewTargetInstancesService(r.compute)
+
+	var mu sync.Mutex
+	list := make(map[string][]compute.TargetInstance)
+	zones, err := r.getZones()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get zones in region")
+	}
+	err = parallel(ctx, len(zones), func(ctx context.Context, i int) error {
+		zone := zones[i]
+
+		resources := make([]compute.TargetInstance, 0)
+		// The pages are requested one by one so if one
+		// fails it's retried without losing the previous ones
+		if err := pages(ctx, func(token string) (string, error) {
+
+			page, err := service.List(r.project, zone).
+				Filter(filter).
+				Fields("nextPageToken", "items(name)").
+				MaxResults(int64(r.maxResults)).
+				PageToken(token).
+				Context(ctx).
+				Do()
+			if err != nil {
+				return "", err
+			}
+			for _, res := range page.Items {
 				resources = append(resources, *res)
 			}
-			return nil
+			return page.NextPageToken, nil
 		}); err != nil {
-		return nil, errors.Wrap(err, "unable to list compute TargetHttpsProxy from google APIs")
+			return errors.Wrap(err, "unable to list compute TargetInstance from google APIs")
+		}
+
+		mu.Lock()
+		list[zone] = resources
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+
+}
+
+// ListTargetGRPCProxies returns a list of TargetGRPCProxies within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListTargetGRPCProxies(ctx context.Context, filter string) ([]compute.TargetGrpcProxy, error) {
+	list, err := r.lists.do("ListTargetGRPCProxies/"+filter, func() (interface{}, error) {
+		return r.listTargetGRPCProxies(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.TargetGrpcProxy), nil
+}
+
+func (r *GCPReader) listTargetGRPCProxies(ctx context.Context, filter string) ([]compute.TargetGrpcProxy, error) {
+	service := compute.NewTargetGrpcProxiesService(r.compute)
+
+	resources := make([]compute.TargetGrpcProxy, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute TargetGrpcProxy from google APIs")
 	}
 
 	return resources, nil
@@ -390,20 +1683,82 @@ func (r *GCPReader) ListTargetHTTPSProxies(ctx context.Context, filter string) (
 }
 
 // ListURLMaps returns a list of URLMaps within a project
+// The result is cached so the same call is only requested once
 func (r *GCPReader) ListURLMaps(ctx context.Context, filter string) ([]compute.UrlMap, error) {
+	list, err := r.lists.do("ListURLMaps/"+filter, func() (interface{}, error) {
+		return r.listURLMaps(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.UrlMap), nil
+}
+
+func (r *GCPReader) listURLMaps(ctx context.Context, filter string) ([]compute.UrlMap, error) {
 	service := compute.NewUrlMapsService(r.compute)
 
 	resources := make([]compute.UrlMap, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
 
-	if err := service.List(r.project).
-		Filter(filter).
-		MaxResults(int64(r.maxResults)).
-		Pages(ctx, func(list *compute.UrlMapList) error {
-			for _, res := range list.Items {
-				resources = append(resources, *res)
-			}
-			return nil
-		}); err != nil {
+		page, err := service.List(r.project).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "unable to list compute UrlMap from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListRegionURLMaps returns a list of RegionURLMaps within a project
+// The result is cached so the same call is only requested once
+func (r *GCPReader) ListRegionURLMaps(ctx context.Context, filter string) ([]compute.UrlMap, error) {
+	list, err := r.lists.do("ListRegionURLMaps/"+filter, func() (interface{}, error) {
+		return r.listRegionURLMaps(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.([]compute.UrlMap), nil
+}
+
+func (r *GCPReader) listRegionURLMaps(ctx context.Context, filter string) ([]compute.UrlMap, error) {
+	service := compute.NewRegionUrlMapsService(r.compute)
+
+	resources := make([]compute.UrlMap, 0)
+	// The pages are requested one by one so if one
+	// fails it's retried without losing the previous ones
+	if err := pages(ctx, func(token string) (string, error) {
+
+		page, err := service.List(r.project, r.region).
+			Filter(filter).
+			Fields("nextPageToken", "items(name)").
+			MaxResults(int64(r.maxResults)).
+			PageToken(token).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return "", err
+		}
+		for _, res := range page.Items {
+			resources = append(resources, *res)
+		}
+		return page.NextPageToken, nil
+	}); err != nil {
 		return nil, errors.Wrap(err, "unable to list compute UrlMap from google APIs")
 	}
 
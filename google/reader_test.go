@@ -0,0 +1,55 @@
+package google
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// headerTransport sets the header on the requests
+// before sending them with the next
+type headerTransport struct {
+	header, value string
+	next          http.RoundTripper
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set(t.header, t.value)
+	return t.next.RoundTrip(req)
+}
+
+func TestNewGcpReader(t *testing.T) {
+	t.Run("ErrMaxResults", func(t *testing.T) {
+		_, err := NewGcpReader(context.Background(), 501, "project", "region", "credentials", Options{})
+		assert.EqualError(t, err, "max-results must be between 0 and 500, inclusive")
+	})
+	t.Run("HTTPClient", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/projects/my-project/global/networks", r.URL.Path)
+			assert.Equal(t, "42", r.Header.Get("X-Trace-Id"))
+			w.Write([]byte(`{"items": [{"name": "default"}]}`))
+		}))
+		defer ts.Close()
+
+		client := &http.Client{Transport: &headerTransport{header: "X-Trace-Id", value: "42", next: ts.Client().Transport}}
+		r, err := NewGcpReader(context.Background(), 500, "my-project", "europe-west1", "", Options{
+			HTTPClient: client,
+			Endpoints:  map[string]string{"compute": ts.URL},
+		})
+		require.NoError(t, err)
+
+		networks, err := r.ListNetworks(context.Background(), "")
+		require.NoError(t, err)
+		require.Len(t, networks, 1)
+		assert.Equal(t, "default", networks[0].Name)
+	})
+	t.Run("ErrHTTPClientWithProxy", func(t *testing.T) {
+		_, err := NewGcpReader(context.Background(), 500, "project", "region", "", Options{HTTPClient: http.DefaultClient, Proxy: "http://proxy:3128"})
+		assert.EqualError(t, err, "the HTTP client can not be used with the proxy or the CA file, they have to be configured on it")
+	})
+}
@@ -0,0 +1,64 @@
+package google
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// tfProviderMajorVersion is the major version of the
+// Google TF provider used to read the resources
+const tfProviderMajorVersion = 3
+
+// resourceTypeRenames has, for each major version of the
+// Google TF provider supported as Options.ProviderVersion,
+// the resource types that have a different name on it than
+// on the tfProviderMajorVersion
+var resourceTypeRenames = map[int]map[ResourceType]string{
+	tfProviderMajorVersion: {},
+	// None of the resource types imported has been
+	// renamed on those versions yet
+	4: {},
+	5: {},
+	6: {},
+}
+
+// validateProviderVersion checks that the version
+// has the renames of its resource types defined
+func validateProviderVersion(version int) error {
+	if version == 0 {
+		return nil
+	}
+	if _, ok := resourceTypeRenames[version]; ok {
+		return nil
+	}
+	versions := make([]int, 0, len(resourceTypeRenames))
+	for v := range resourceTypeRenames {
+		versions = append(versions, v)
+	}
+	sort.Ints(versions)
+	return errors.Errorf("the provider version %d is not supported, it must be one of %v", version, versions)
+}
+
+// typeName returns the name of the resource type t
+// on the major version of the Google TF provider
+func typeName(version int, t string) string {
+	rt, err := ResourceTypeString(t)
+	if err != nil {
+		return t
+	}
+	if n, ok := resourceTypeRenames[version][rt]; ok {
+		return n
+	}
+	return t
+}
+
+// versionConstraint returns the constraint of the TF
+// provider on the major version v, empty if it's 0
+func versionConstraint(v int) string {
+	if v == 0 {
+		return ""
+	}
+	return fmt.Sprintf("~> %d.0", v)
+}
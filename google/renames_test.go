@@ -0,0 +1,39 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cycloidio/terracognita/provider"
+)
+
+func TestValidateProviderVersion(t *testing.T) {
+	assert.NoError(t, validateProviderVersion(0))
+	assert.NoError(t, validateProviderVersion(tfProviderMajorVersion))
+	assert.NoError(t, validateProviderVersion(4))
+	assert.EqualError(t, validateProviderVersion(2), "the provider version 2 is not supported, it must be one of [3 4 5 6]")
+}
+
+func TestTypeName(t *testing.T) {
+	renames := resourceTypeRenames
+	defer func() { resourceTypeRenames = renames }()
+	resourceTypeRenames = map[int]map[ResourceType]string{
+		tfProviderMajorVersion: {},
+		4:                      {ComputeInstance: "google_compute_vm"},
+	}
+
+	g := &google{options: Options{ProviderVersion: 4}}
+	assert.Equal(t, "google_compute_vm", provider.TypeName(g, "google_compute_instance"))
+	assert.Equal(t, "google_compute_disk", provider.TypeName(g, "google_compute_disk"))
+	assert.Equal(t, "google_unknown", provider.TypeName(g, "google_unknown"))
+
+	g = &google{}
+	assert.Equal(t, "google_compute_instance", provider.TypeName(g, "google_compute_instance"))
+}
+
+func TestVersionConstraint(t *testing.T) {
+	assert.Equal(t, "", versionConstraint(0))
+	assert.Equal(t, "~> 4.0", versionConstraint(4))
+	assert.Equal(t, "~> 3.0", (&google{options: Options{ProviderVersion: 3}}).VersionConstraint())
+}
@@ -4,11 +4,17 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/url"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 
 	"github.com/cycloidio/terracognita/filter"
 	"github.com/cycloidio/terracognita/provider"
+	"google.golang.org/api/binaryauthorization/v1"
+	"google.golang.org/api/composer/v1"
+	"google.golang.org/api/compute/v1"
 )
 
 // ResourceType is the type used to define all the Resources
@@ -20,27 +26,97 @@ const (
 	ComputeInstance ResourceType = iota
 	ComputeFirewall
 	ComputeNetwork
+	ComputeNetworkPeering
 	// With Google, an HTTP(S) load balancer has 3 parts:
 	// * backend configuration: instance_group, backend_service and health_check
 	// * host and path rules: url_map
 	// * frontend configuration: target_http(s)_proxy + global_forwarding_rule
 	ComputeHealthCheck
+	ComputeRegionHealthCheck
+	// The legacy health checks are the ones
+	// used by the target pools
+	ComputeHTTPHealthCheck
+	ComputeHTTPSHealthCheck
 	ComputeInstanceGroup
+	ComputeInstanceGroupNamedPort
 	ComputeInstanceIAMPolicy
+	ComputeInstanceGroupManager
+	ComputeRegionInstanceGroupManager
+	// The per instance configs are the ones
+	// of the stateful managed instance groups
+	ComputePerInstanceConfig
+	ComputeRegionPerInstanceConfig
 	ComputeBackendBucket
 	ComputeBackendService
 	ComputeSSLCertificate
 	ComputeTargetHTTPProxy
 	ComputeTargetHTTPSProxy
 	ComputeURLMap
+	ComputeRegionSSLCertificate
+	ComputeRegionTargetHTTPProxy
+	ComputeRegionTargetHTTPSProxy
+	ComputeRegionURLMap
 	ComputeGlobalForwardingRule
 	ComputeForwardingRule
 	ComputeDisk
+	ComputeResourcePolicy
+	ComputeDiskResourcePolicyAttachment
+	ComputeAttachedDisk
+	ComputeNodeTemplate
+	ComputeNodeGroup
+	ComputeGlobalNetworkEndpointGroup
+	ComputeRegionNetworkEndpointGroup
+	ComputeInterconnectAttachment
+	ComputeExternalVPNGateway
+	ComputeReservation
+	ComputeSSLPolicy
+	ComputeTargetSSLProxy
+	ComputeTargetTCPProxy
+	ComputeTargetInstance
+	ComputeTargetGRPCProxy
+	ComputeProjectMetadata
+	ComputeProjectDefaultNetworkTier
+	CloudSchedulerJob
+	CloudTasksQueue
+	WorkflowsWorkflow
+	EventarcTrigger
+	ComposerEnvironment
+	OSConfigPatchDeployment
+	FilestoreInstance
+	PubsubTopicIAMPolicy
+	LoggingProjectSink
+	LoggingMetric
+	MonitoringAlertPolicy
+	MonitoringNotificationChannel
+	DataprocCluster
+	IAPBrand
+	IAPWebIAMPolicy
+	IAPWebBackendServiceIAMPolicy
+	CloudIdentityGroup
+	CloudIdentityGroupMembership
+	AppEngineApplication
+	AppEngineStandardAppVersion
+	AppEngineServiceSplitTraffic
+	BigtableInstance
+	BigtableTable
 	DNSManagedZone
 	DNSRecordSet
 	ProjectIAMCustomRole
+	OrganizationIAMCustomRole
+	FolderIAMPolicy
+	BillingAccountIAMPolicy
+	// The Resource Manager tags, not
+	// to confuse with the labels
+	TagsTagKey
+	TagsTagValue
+	TagsTagBinding
+	AccessContextManagerAccessLevel
+	AccessContextManagerServicePerimeter
+	BinaryAuthorizationPolicy
+	BinaryAuthorizationAttestor
 	StorageBucket
 	StorageBucketIAMPolicy
+	StorageTransferJob
 	SQLDatabaseInstance
 
 	noFilter = ""
@@ -50,41 +126,381 @@ type rtFn func(ctx context.Context, g *google, resourceType string, filters *fil
 
 var (
 	resources = map[ResourceType]rtFn{
-		ComputeInstance:             computeInstance,
-		ComputeFirewall:             computeFirewall,
-		ComputeNetwork:              computeNetwork,
-		ComputeHealthCheck:          computeHealthCheck,
-		ComputeInstanceGroup:        computeInstanceGroup,
-		ComputeInstanceIAMPolicy:    computeInstanceIAMPolicy,
-		ComputeBackendService:       computeBackendService,
-		ComputeBackendBucket:        computeBackendBucket,
-		ComputeSSLCertificate:       computeSSLCertificate,
-		ComputeTargetHTTPProxy:      computeTargetHTTPProxy,
-		ComputeTargetHTTPSProxy:     computeTargetHTTPSProxy,
-		ComputeURLMap:               computeURLMap,
-		ComputeGlobalForwardingRule: computeGlobalForwardingRule,
-		ComputeForwardingRule:       computeForwardingRule,
-		ComputeDisk:                 computeDisk,
-		DNSManagedZone:              managedZoneDNS,
-		DNSRecordSet:                recordSetDNS,
-		ProjectIAMCustomRole:        projectIAMCustomRole,
-		StorageBucket:               storageBucket,
-		StorageBucketIAMPolicy:      storageBucketIAMPolicy,
-		SQLDatabaseInstance:         sqlDatabaseInstance,
+		ComputeInstance:                      computeInstance,
+		ComputeFirewall:                      computeFirewall,
+		ComputeNetwork:                       computeNetwork,
+		ComputeNetworkPeering:                computeNetworkPeering,
+		ComputeHealthCheck:                   computeHealthCheck,
+		ComputeRegionHealthCheck:             computeRegionHealthCheck,
+		ComputeHTTPHealthCheck:               computeHTTPHealthCheck,
+		ComputeHTTPSHealthCheck:              computeHTTPSHealthCheck,
+		ComputeInstanceGroup:                 computeInstanceGroup,
+		ComputeInstanceGroupNamedPort:        computeInstanceGroupNamedPort,
+		ComputeInstanceIAMPolicy:             computeInstanceIAMPolicy,
+		ComputeInstanceGroupManager:          computeInstanceGroupManager,
+		ComputeRegionInstanceGroupManager:    computeRegionInstanceGroupManager,
+		ComputePerInstanceConfig:             computePerInstanceConfig,
+		ComputeRegionPerInstanceConfig:       computeRegionPerInstanceConfig,
+		ComputeBackendService:                computeBackendService,
+		ComputeBackendBucket:                 computeBackendBucket,
+		ComputeSSLCertificate:                computeSSLCertificate,
+		ComputeTargetHTTPProxy:               computeTargetHTTPProxy,
+		ComputeTargetHTTPSProxy:              computeTargetHTTPSProxy,
+		ComputeURLMap:                        computeURLMap,
+		ComputeRegionSSLCertificate:          computeRegionSSLCertificate,
+		ComputeRegionTargetHTTPProxy:         computeRegionTargetHTTPProxy,
+		ComputeRegionTargetHTTPSProxy:        computeRegionTargetHTTPSProxy,
+		ComputeRegionURLMap:                  computeRegionURLMap,
+		ComputeGlobalForwardingRule:          computeGlobalForwardingRule,
+		ComputeForwardingRule:                computeForwardingRule,
+		ComputeDisk:                          computeDisk,
+		ComputeResourcePolicy:                computeResourcePolicy,
+		ComputeDiskResourcePolicyAttachment:  computeDiskResourcePolicyAttachment,
+		ComputeAttachedDisk:                  computeAttachedDisk,
+		ComputeNodeTemplate:                  computeNodeTemplate,
+		ComputeNodeGroup:                     computeNodeGroup,
+		ComputeGlobalNetworkEndpointGroup:    computeGlobalNetworkEndpointGroup,
+		ComputeRegionNetworkEndpointGroup:    computeRegionNetworkEndpointGroup,
+		ComputeInterconnectAttachment:        computeInterconnectAttachment,
+		ComputeExternalVPNGateway:            computeExternalVPNGateway,
+		ComputeReservation:                   computeReservation,
+		ComputeSSLPolicy:                     computeSSLPolicy,
+		ComputeTargetSSLProxy:                computeTargetSSLProxy,
+		ComputeTargetTCPProxy:                computeTargetTCPProxy,
+		ComputeTargetInstance:                computeTargetInstance,
+		ComputeTargetGRPCProxy:               computeTargetGRPCProxy,
+		ComputeProjectMetadata:               computeProjectMetadata,
+		ComputeProjectDefaultNetworkTier:     computeProjectDefaultNetworkTier,
+		CloudSchedulerJob:                    cloudSchedulerJob,
+		CloudTasksQueue:                      cloudTasksQueue,
+		WorkflowsWorkflow:                    workflowsWorkflow,
+		EventarcTrigger:                      eventarcTrigger,
+		ComposerEnvironment:                  composerEnvironment,
+		OSConfigPatchDeployment:              osConfigPatchDeployment,
+		FilestoreInstance:                    filestoreInstance,
+		PubsubTopicIAMPolicy:                 pubsubTopicIAMPolicy,
+		LoggingProjectSink:                   loggingProjectSink,
+		LoggingMetric:                        loggingMetric,
+		MonitoringAlertPolicy:                monitoringAlertPolicy,
+		MonitoringNotificationChannel:        monitoringNotificationChannel,
+		DataprocCluster:                      dataprocCluster,
+		IAPBrand:                             iapBrand,
+		IAPWebIAMPolicy:                      iapWebIAMPolicy,
+		IAPWebBackendServiceIAMPolicy:        iapWebBackendServiceIAMPolicy,
+		CloudIdentityGroup:                   cloudIdentityGroup,
+		CloudIdentityGroupMembership:         cloudIdentityGroupMembership,
+		AppEngineApplication:                 appEngineApplication,
+		AppEngineStandardAppVersion:          appEngineStandardAppVersion,
+		AppEngineServiceSplitTraffic:         appEngineServiceSplitTraffic,
+		BigtableInstance:                     bigtableInstance,
+		BigtableTable:                        bigtableTable,
+		DNSManagedZone:                       managedZoneDNS,
+		DNSRecordSet:                         recordSetDNS,
+		ProjectIAMCustomRole:                 projectIAMCustomRole,
+		OrganizationIAMCustomRole:            organizationIAMCustomRole,
+		FolderIAMPolicy:                      folderIAMPolicy,
+		BillingAccountIAMPolicy:              billingAccountIAMPolicy,
+		TagsTagKey:                           tagsTagKey,
+		TagsTagValue:                         tagsTagValue,
+		TagsTagBinding:                       tagsTagBinding,
+		AccessContextManagerAccessLevel:      accessContextManagerAccessLevel,
+		AccessContextManagerServicePerimeter: accessContextManagerServicePerimeter,
+		BinaryAuthorizationPolicy:            binaryAuthorizationPolicy,
+		BinaryAuthorizationAttestor:          binaryAuthorizationAttestor,
+		StorageBucket:                        storageBucket,
+		StorageBucketIAMPolicy:               storageBucketIAMPolicy,
+		StorageTransferJob:                   storageTransferJob,
+		SQLDatabaseInstance:                  sqlDatabaseInstance,
 	}
 )
 
+// filterValueEscaper escapes the characters that can not
+// be inside a quoted string of the filters of the APIs
+var filterValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
 func initializeFilter(filters *filter.Filter) string {
 	var b bytes.Buffer
 	for _, t := range filters.Tags {
-		// if multiple tags, we suppose it's a "AND" operation
-		b.WriteString(fmt.Sprintf("(labels.%s=%s) ", t.Name, t.Value))
+		// if multiple tags, we suppose it's a "AND" operation.
+		// The value is quoted so it can have spaces, parentheses
+		// or quotes, the key can not as the labels keys
+		// only accept lowercase letters, numbers, _ and -
+		b.WriteString(fmt.Sprintf(`(labels.%s="%s") `, t.Name, filterValueEscaper.Replace(t.Value)))
 	}
 	return b.String()
 }
 
+// initializeDataprocFilter returns the filter of the labels for
+// Dataproc, which does not support the format of initializeFilter
+// as it needs the explicit AND between the labels
+func initializeDataprocFilter(filters *filter.Filter) string {
+	labels := make([]string, 0, len(filters.Tags))
+	for _, t := range filters.Tags {
+		labels = append(labels, fmt.Sprintf(`labels.%s = "%s"`, t.Name, filterValueEscaper.Replace(t.Value)))
+	}
+	return strings.Join(labels, " AND ")
+}
+
+// matchLabels checks if the labels have all the Tags of the
+// filters, for the List calls that do not accept a filter
+func matchLabels(labels map[string]string, filters *filter.Filter) bool {
+	for _, t := range filters.Tags {
+		if v, ok := labels[t.Name]; !ok || v != t.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// matchNetwork checks if one of the networks, URLs of the API
+// (ex: https://www.googleapis.com/compute/v1/projects/p/global/networks/default),
+// is the Network of the filters, which can be its name or its path
+// (ex: default or projects/p/global/networks/default).
+// If the filters have no Network it always matches
+func matchNetwork(filters *filter.Filter, networks ...string) bool {
+	if filters.Network == "" {
+		return true
+	}
+	for _, n := range networks {
+		if n == filters.Network || strings.HasSuffix(n, "/"+filters.Network) {
+			return true
+		}
+	}
+	return false
+}
+
+// statusFilters returns the statuses of the filters that are
+// valid for the rt, on the statusFilterResourceTypes.
+// If it's empty the resources are not filtered by status
+func statusFilters(rt ResourceType, filters *filter.Filter) []string {
+	valid, ok := statusFilterResourceTypes[rt]
+	if !ok || len(filters.Status) == 0 {
+		return nil
+	}
+	statuses := make([]string, 0, len(filters.Status))
+	for _, s := range filters.Status {
+		for _, v := range valid {
+			if strings.EqualFold(s, v) {
+				statuses = append(statuses, v)
+				break
+			}
+		}
+	}
+	return statuses
+}
+
+// initializeStatusFilter returns the filter on the status for
+// the List calls that accept one. As the OR can not be combined
+// with the rest of the filter, it's only pushed with one status
+// and the matchStatus has to be used anyway
+func initializeStatusFilter(rt ResourceType, filters *filter.Filter) string {
+	statuses := statusFilters(rt, filters)
+	if len(statuses) != 1 {
+		return ""
+	}
+	return fmt.Sprintf(`(status = "%s") `, statuses[0])
+}
+
+// matchStatus checks if the status is one of the statuses
+// of the filters valid for the rt, if none it always matches
+func matchStatus(rt ResourceType, filters *filter.Filter, status string) bool {
+	statuses := statusFilters(rt, filters)
+	if len(statuses) == 0 {
+		return true
+	}
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// rawFilterResourceTypes are the ResourceTypes which List call
+// accepts a filter, so the ones that support the Options.RawFilters
+var rawFilterResourceTypes = map[ResourceType]struct{}{
+	ComputeInstance:                     {},
+	ComputeFirewall:                     {},
+	ComputeNetwork:                      {},
+	ComputeNetworkPeering:               {},
+	ComputeHealthCheck:                  {},
+	ComputeRegionHealthCheck:            {},
+	ComputeHTTPHealthCheck:              {},
+	ComputeHTTPSHealthCheck:             {},
+	ComputeInstanceGroup:                {},
+	ComputeInstanceGroupNamedPort:       {},
+	ComputeInstanceIAMPolicy:            {},
+	ComputeInstanceGroupManager:         {},
+	ComputeRegionInstanceGroupManager:   {},
+	ComputePerInstanceConfig:            {},
+	ComputeRegionPerInstanceConfig:      {},
+	ComputeBackendBucket:                {},
+	ComputeBackendService:               {},
+	ComputeSSLCertificate:               {},
+	ComputeTargetHTTPProxy:              {},
+	ComputeTargetHTTPSProxy:             {},
+	ComputeURLMap:                       {},
+	ComputeRegionSSLCertificate:         {},
+	ComputeRegionTargetHTTPProxy:        {},
+	ComputeRegionTargetHTTPSProxy:       {},
+	ComputeRegionURLMap:                 {},
+	ComputeGlobalForwardingRule:         {},
+	ComputeForwardingRule:               {},
+	ComputeDisk:                         {},
+	ComputeResourcePolicy:               {},
+	ComputeDiskResourcePolicyAttachment: {},
+	ComputeAttachedDisk:                 {},
+	ComputeNodeTemplate:                 {},
+	ComputeNodeGroup:                    {},
+	ComputeGlobalNetworkEndpointGroup:   {},
+	ComputeRegionNetworkEndpointGroup:   {},
+	ComputeInterconnectAttachment:       {},
+	ComputeExternalVPNGateway:           {},
+	ComputeReservation:                  {},
+	ComputeSSLPolicy:                    {},
+	ComputeTargetSSLProxy:               {},
+	ComputeTargetTCPProxy:               {},
+	ComputeTargetInstance:               {},
+	ComputeTargetGRPCProxy:              {},
+	FilestoreInstance:                   {},
+	MonitoringAlertPolicy:               {},
+	MonitoringNotificationChannel:       {},
+	SQLDatabaseInstance:                 {},
+}
+
+// labelFilterResourceTypes are the ResourceTypes which
+// are filtered by the labels of the filter.Filter Tags
+var labelFilterResourceTypes = map[ResourceType]struct{}{
+	ComputeInstance:                     {},
+	ComputeInstanceIAMPolicy:            {},
+	ComputeGlobalForwardingRule:         {},
+	ComputeForwardingRule:               {},
+	ComputeDisk:                         {},
+	ComputeDiskResourcePolicyAttachment: {},
+	ComputeAttachedDisk:                 {},
+	ComputeExternalVPNGateway:           {},
+	FilestoreInstance:                   {},
+	DataprocCluster:                     {},
+	BigtableInstance:                    {},
+	BigtableTable:                       {},
+	WorkflowsWorkflow:                   {},
+	EventarcTrigger:                     {},
+	ComposerEnvironment:                 {},
+}
+
+// networkFilterResourceTypes are the ResourceTypes which
+// are filtered by the filter.Filter Network
+var networkFilterResourceTypes = map[ResourceType]struct{}{
+	ComputeInstance:                   {},
+	ComputeGlobalForwardingRule:       {},
+	ComputeForwardingRule:             {},
+	ComputeGlobalNetworkEndpointGroup: {},
+	ComputeRegionNetworkEndpointGroup: {},
+}
+
+// experimentalResourceTypes are the ResourceTypes which import
+// is not stable yet, so they are only imported when enabled
+// with the Options.Experimental
+var experimentalResourceTypes = map[ResourceType]struct{}{
+	BinaryAuthorizationPolicy:   {},
+	BinaryAuthorizationAttestor: {},
+}
+
+// IsExperimental checks if the resource type with
+// the name is only imported with the Options.Experimental
+func IsExperimental(name string) bool {
+	rt, err := ResourceTypeString(name)
+	if err != nil {
+		return false
+	}
+	_, ok := experimentalResourceTypes[rt]
+	return ok
+}
+
+// resourceTypes returns the names of the ResourceTypes
+// imported by default with the opts
+func resourceTypes(opts Options) []string {
+	if opts.Experimental {
+		return ResourceTypeStrings()
+	}
+	rts := make([]string, 0, len(resources))
+	for _, rt := range ResourceTypeValues() {
+		if _, ok := experimentalResourceTypes[rt]; !ok {
+			rts = append(rts, rt.String())
+		}
+	}
+	return rts
+}
+
+// statusFilterResourceTypes are the ResourceTypes which are
+// filtered by the filter.Filter Status with the statuses valid
+// for each of them
+var statusFilterResourceTypes = map[ResourceType][]string{
+	ComputeInstance:     {"PROVISIONING", "STAGING", "RUNNING", "STOPPING", "SUSPENDING", "SUSPENDED", "REPAIRING", "TERMINATED"},
+	ComputeDisk:         {"CREATING", "RESTORING", "FAILED", "READY", "DELETING"},
+	FilestoreInstance:   {"CREATING", "READY", "REPAIRING", "DELETING", "ERROR"},
+	DataprocCluster:     {"CREATING", "RUNNING", "ERROR", "DELETING", "UPDATING", "STOPPING", "STOPPED", "STARTING"},
+	SQLDatabaseInstance: {"RUNNABLE", "SUSPENDED", "PENDING_DELETE", "PENDING_CREATE", "MAINTENANCE", "FAILED"},
+}
+
+// SupportedResourceType is a ResourceType that
+// can be imported with the filters it supports
+type SupportedResourceType struct {
+	// Type is the ResourceType
+	Type ResourceType
+
+	// Name is the TF name of the Type (ex: google_compute_instance)
+	Name string
+
+	// LabelFilter is true if the Type is filtered by the labels
+	LabelFilter bool
+
+	// NetworkFilter is true if the Type is filtered by the network
+	NetworkFilter bool
+
+	// Statuses are the statuses by which the Type can
+	// be filtered, empty if it's not filtered by status
+	Statuses []string
+
+	// Experimental is true if the Type is only
+	// imported with the Options.Experimental
+	Experimental bool
+}
+
+// SupportedResourceTypes returns all the ResourceTypes that can be imported
+func SupportedResourceTypes() []SupportedResourceType {
+	rts := make([]SupportedResourceType, 0, len(resources))
+	for _, rt := range ResourceTypeValues() {
+		if _, ok := resources[rt]; !ok {
+			continue
+		}
+		_, lf := labelFilterResourceTypes[rt]
+		_, nf := networkFilterResourceTypes[rt]
+		_, ex := experimentalResourceTypes[rt]
+		rts = append(rts, SupportedResourceType{
+			Type:          rt,
+			Name:          rt.String(),
+			LabelFilter:   lf,
+			NetworkFilter: nf,
+			Statuses:      statusFilterResourceTypes[rt],
+			Experimental:  ex,
+		})
+	}
+	return rts
+}
+
+// listFilter returns the filter f ANDed with the raw
+// filter configured for the resourceType, if any
+func (g *google) listFilter(resourceType, f string) string {
+	raw, ok := g.options.RawFilters[resourceType]
+	if !ok {
+		return f
+	}
+	return fmt.Sprintf("(%s) %s", raw, f)
+}
+
 func computeInstance(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	f := initializeFilter(filters)
+	f := g.listFilter(resourceType, initializeFilter(filters)+initializeStatusFilter(ComputeInstance, filters))
 	instancesList, err := g.gcpr.ListInstances(ctx, f)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list instances from reader")
@@ -92,7 +508,22 @@ func computeInstance(ctx context.Context, g *google, resourceType string, filter
 	resources := make([]provider.Resource, 0)
 	for z, instances := range instancesList {
 		for _, instance := range instances {
+			if !matchStatus(ComputeInstance, filters, instance.Status) {
+				continue
+			}
+			networks := make([]string, 0, len(instance.NetworkInterfaces))
+			for _, ni := range instance.NetworkInterfaces {
+				networks = append(networks, ni.Network)
+			}
+			if !matchNetwork(filters, networks...) {
+				continue
+			}
 			r := provider.NewResource(fmt.Sprintf("%s/%s/%s", g.Project(), z, instance.Name), resourceType, g)
+			// we set the machine type prior of reading it from the state
+			// so it can be used by the Options.ResourceFilter
+			if err := r.Data().Set("machine_type", instance.MachineType); err != nil {
+				return nil, errors.Wrapf(err, "unable to set machine_type data on the provider.Resource for the instance '%s'", instance.Name)
+			}
 			resources = append(resources, r)
 		}
 	}
@@ -100,20 +531,63 @@ func computeInstance(ctx context.Context, g *google, resourceType string, filter
 }
 
 func computeFirewall(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	firewalls, err := g.gcpr.ListFirewalls(ctx, noFilter)
+	firewalls, err := g.gcpr.ListFirewalls(ctx, g.listFilter(resourceType, noFilter))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list firewalls from reader")
 	}
 	resources := make([]provider.Resource, 0)
 	for _, firewall := range firewalls {
 		r := provider.NewResource(firewall.Name, resourceType, g)
+		// we set the rules prior of reading them from the state
+		// so they can be used by the Options.ResourceFilter
+		if err := setFirewallData(r, firewall); err != nil {
+			return nil, errors.Wrapf(err, "unable to set the rules data on the provider.Resource for the firewall '%s'", firewall.Name)
+		}
 		resources = append(resources, r)
 	}
 	return resources, nil
 }
 
+// setFirewallData sets the rules of the firewall on the Data
+// of r with the same format as the TF google_compute_firewall
+func setFirewallData(r provider.Resource, firewall compute.Firewall) error {
+	allow := make([]interface{}, 0, len(firewall.Allowed))
+	for _, a := range firewall.Allowed {
+		allow = append(allow, map[string]interface{}{
+			"protocol": a.IPProtocol,
+			"ports":    a.Ports,
+		})
+	}
+	deny := make([]interface{}, 0, len(firewall.Denied))
+	for _, d := range firewall.Denied {
+		deny = append(deny, map[string]interface{}{
+			"protocol": d.IPProtocol,
+			"ports":    d.Ports,
+		})
+	}
+
+	data := map[string]interface{}{
+		"allow":                   allow,
+		"deny":                    deny,
+		"direction":               firewall.Direction,
+		"priority":                firewall.Priority,
+		"source_ranges":           firewall.SourceRanges,
+		"destination_ranges":      firewall.DestinationRanges,
+		"source_tags":             firewall.SourceTags,
+		"target_tags":             firewall.TargetTags,
+		"source_service_accounts": firewall.SourceServiceAccounts,
+		"target_service_accounts": firewall.TargetServiceAccounts,
+	}
+	for k, v := range data {
+		if err := r.Data().Set(k, v); err != nil {
+			return errors.Wrapf(err, "unable to set %s", k)
+		}
+	}
+	return nil
+}
+
 func computeNetwork(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	networks, err := g.gcpr.ListNetworks(ctx, noFilter)
+	networks, err := g.gcpr.ListNetworks(ctx, g.listFilter(resourceType, noFilter))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list networks from reader")
 	}
@@ -125,8 +599,27 @@ func computeNetwork(ctx context.Context, g *google, resourceType string, filters
 	return resources, nil
 }
 
+// computeNetworkPeering will import the peerings of the networks.
+// We need to iterate over the network list
+func computeNetworkPeering(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	peeringsList, err := g.gcpr.ListNetworkPeerings(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list network peerings from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for network, peerings := range peeringsList {
+		for _, peering := range peerings {
+			// The peer network is read by TF from
+			// the peering so it's linked to it
+			r := provider.NewResource(fmt.Sprintf("%s/%s/%s", g.Project(), network, peering.Name), resourceType, g)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
 func computeHealthCheck(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	checks, err := g.gcpr.ListHealthChecks(ctx, noFilter)
+	checks, err := g.gcpr.ListHealthChecks(ctx, g.listFilter(resourceType, noFilter))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list health checks from reader")
 	}
@@ -138,8 +631,47 @@ func computeHealthCheck(ctx context.Context, g *google, resourceType string, fil
 	return resources, nil
 }
 
+func computeRegionHealthCheck(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	checks, err := g.gcpr.ListRegionHealthChecks(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list region health checks from reader")
+	}
+	resources := make([]provider.Resource, 0, len(checks))
+	for _, check := range checks {
+		r := provider.NewResource(fmt.Sprintf("projects/%s/regions/%s/healthChecks/%s", g.Project(), g.Region(), check.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func computeHTTPHealthCheck(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	checks, err := g.gcpr.ListHTTPHealthChecks(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list HTTP health checks from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, check := range checks {
+		r := provider.NewResource(check.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func computeHTTPSHealthCheck(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	checks, err := g.gcpr.ListHTTPSHealthChecks(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list HTTPS health checks from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, check := range checks {
+		r := provider.NewResource(check.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
 func computeInstanceGroup(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	instanceGroups, err := g.gcpr.ListInstanceGroups(ctx, noFilter)
+	instanceGroups, err := g.gcpr.ListInstanceGroups(ctx, g.listFilter(resourceType, noFilter))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list instance groups from reader")
 	}
@@ -153,21 +685,193 @@ func computeInstanceGroup(ctx context.Context, g *google, resourceType string, f
 	return resources, nil
 }
 
+// computeInstanceGroupNamedPort imports the named ports of the instance groups
+// as independent resources, they are also part of the google_compute_instance_group
+// so only one of both types should be used to manage them
+func computeInstanceGroupNamedPort(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	instanceGroups, err := g.gcpr.ListInstanceGroups(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list instance groups from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for z, groups := range instanceGroups {
+		for _, group := range groups {
+			for _, port := range group.NamedPorts {
+				r := provider.NewResource(fmt.Sprintf("projects/%s/zones/%s/instanceGroups/%s/%d/%s", g.Project(), z, group.Name, port.Port, port.Name), resourceType, g)
+				resources = append(resources, r)
+			}
+		}
+	}
+	return resources, nil
+}
+
+func computeInstanceGroupManager(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	managersList, err := g.gcpr.ListInstanceGroupManagers(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list instance group managers from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for z, managers := range managersList {
+		for _, manager := range managers {
+			r := provider.NewResource(fmt.Sprintf("projects/%s/zones/%s/instanceGroupManagers/%s", g.Project(), z, manager.Name), resourceType, g)
+			if err := setInstanceGroupManagerData(r, manager.Versions, manager.UpdatePolicy); err != nil {
+				return nil, errors.Wrapf(err, "unable to set the versions data on the provider.Resource for the instance group manager '%s'", manager.Name)
+			}
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+func computeRegionInstanceGroupManager(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	managers, err := g.gcpr.ListRegionInstanceGroupManagers(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list region instance group managers from reader")
+	}
+	resources := make([]provider.Resource, 0, len(managers))
+	for _, manager := range managers {
+		r := provider.NewResource(fmt.Sprintf("projects/%s/regions/%s/instanceGroupManagers/%s", g.Project(), g.Region(), manager.Name), resourceType, g)
+		if err := setInstanceGroupManagerData(r, manager.Versions, manager.UpdatePolicy); err != nil {
+			return nil, errors.Wrapf(err, "unable to set the versions data on the provider.Resource for the region instance group manager '%s'", manager.Name)
+		}
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// setInstanceGroupManagerData sets the versions, each with its
+// instance template, and the update policy of a manager on the r
+// prior of reading them from the state so they can be used by the
+// Options.ResourceFilter. The canary rollouts have more than one version
+func setInstanceGroupManagerData(r provider.Resource, versions []*compute.InstanceGroupManagerVersion, policy *compute.InstanceGroupManagerUpdatePolicy) error {
+	vs := make([]interface{}, 0, len(versions))
+	for _, v := range versions {
+		size := make([]interface{}, 0, 1)
+		if ts := v.TargetSize; ts != nil && ts.Percent > 0 {
+			size = append(size, map[string]interface{}{"percent": ts.Percent})
+		} else if ts != nil && ts.Fixed > 0 {
+			size = append(size, map[string]interface{}{"fixed": ts.Fixed})
+		}
+		vs = append(vs, map[string]interface{}{
+			"name":              v.Name,
+			"instance_template": v.InstanceTemplate,
+			"target_size":       size,
+		})
+	}
+	if err := r.Data().Set("version", vs); err != nil {
+		return errors.Wrap(err, "unable to set version")
+	}
+
+	if policy == nil {
+		return nil
+	}
+	up := map[string]interface{}{
+		"minimal_action": policy.MinimalAction,
+		"type":           policy.Type,
+	}
+	if policy.MaxSurge != nil {
+		up["max_surge_fixed"] = policy.MaxSurge.Fixed
+		up["max_surge_percent"] = policy.MaxSurge.Percent
+	}
+	if policy.MaxUnavailable != nil {
+		up["max_unavailable_fixed"] = policy.MaxUnavailable.Fixed
+		up["max_unavailable_percent"] = policy.MaxUnavailable.Percent
+	}
+	if err := r.Data().Set("update_policy", []interface{}{up}); err != nil {
+		return errors.Wrap(err, "unable to set update_policy")
+	}
+	return nil
+}
+
+func computePerInstanceConfig(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	managersList, err := g.gcpr.ListInstanceGroupManagers(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list instance group managers from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for z, managers := range managersList {
+		for _, manager := range managers {
+			configs, err := g.gcpr.ListPerInstanceConfigs(ctx, z, manager.Name)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to list per instance configs from reader")
+			}
+			for _, config := range configs {
+				r := provider.NewResource(fmt.Sprintf("projects/%s/zones/%s/instanceGroupManagers/%s/%s", g.Project(), z, manager.Name, config.Name), resourceType, g)
+				resources = append(resources, r)
+			}
+		}
+	}
+	return resources, nil
+}
+
+func computeRegionPerInstanceConfig(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	managers, err := g.gcpr.ListRegionInstanceGroupManagers(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list region instance group managers from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, manager := range managers {
+		configs, err := g.gcpr.ListRegionPerInstanceConfigs(ctx, manager.Name)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list region per instance configs from reader")
+		}
+		for _, config := range configs {
+			r := provider.NewResource(fmt.Sprintf("projects/%s/regions/%s/instanceGroupManagers/%s/%s", g.Project(), g.Region(), manager.Name, config.Name), resourceType, g)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
 func computeBackendService(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	backends, err := g.gcpr.ListBackendServices(ctx, noFilter)
+	backends, err := g.gcpr.ListBackendServices(ctx, g.listFilter(resourceType, noFilter))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list backend services from reader")
 	}
 	resources := make([]provider.Resource, 0)
 	for _, backend := range backends {
 		r := provider.NewResource(backend.Name, resourceType, g)
+		if err := setComputeBackendServiceData(r, backend); err != nil {
+			return nil, errors.Wrapf(err, "unable to set data of backend service %s", backend.Name)
+		}
 		resources = append(resources, r)
 	}
 	return resources, nil
 }
 
+// setComputeBackendServiceData sets the Cloud CDN
+// configuration of the backend, with its cache key policy
+func setComputeBackendServiceData(r provider.Resource, backend compute.BackendService) error {
+	data := map[string]interface{}{
+		"enable_cdn": backend.EnableCDN,
+	}
+	if p := backend.CdnPolicy; p != nil {
+		cdn := map[string]interface{}{
+			"signed_url_cache_max_age_sec": int(p.SignedUrlCacheMaxAgeSec),
+		}
+		if k := p.CacheKeyPolicy; k != nil {
+			cdn["cache_key_policy"] = []interface{}{
+				map[string]interface{}{
+					"include_host":           k.IncludeHost,
+					"include_protocol":       k.IncludeProtocol,
+					"include_query_string":   k.IncludeQueryString,
+					"query_string_blacklist": k.QueryStringBlacklist,
+					"query_string_whitelist": k.QueryStringWhitelist,
+				},
+			}
+		}
+		data["cdn_policy"] = []interface{}{cdn}
+	}
+	for k, v := range data {
+		if err := r.Data().Set(k, v); err != nil {
+			return errors.Wrapf(err, "unable to set %s", k)
+		}
+	}
+	return nil
+}
+
 func computeURLMap(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	maps, err := g.gcpr.ListURLMaps(ctx, noFilter)
+	maps, err := g.gcpr.ListURLMaps(ctx, g.listFilter(resourceType, noFilter))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list URL maps from reader")
 	}
@@ -180,7 +884,7 @@ func computeURLMap(ctx context.Context, g *google, resourceType string, filters
 }
 
 func computeTargetHTTPProxy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	targets, err := g.gcpr.ListTargetHTTPProxies(ctx, noFilter)
+	targets, err := g.gcpr.ListTargetHTTPProxies(ctx, g.listFilter(resourceType, noFilter))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list target http proxies from reader")
 	}
@@ -193,7 +897,7 @@ func computeTargetHTTPProxy(ctx context.Context, g *google, resourceType string,
 }
 
 func computeTargetHTTPSProxy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	targets, err := g.gcpr.ListTargetHTTPSProxies(ctx, noFilter)
+	targets, err := g.gcpr.ListTargetHTTPSProxies(ctx, g.listFilter(resourceType, noFilter))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list target https proxies from reader")
 	}
@@ -206,7 +910,7 @@ func computeTargetHTTPSProxy(ctx context.Context, g *google, resourceType string
 }
 
 func computeSSLCertificate(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	certs, err := g.gcpr.ListSSLCertificates(ctx, noFilter)
+	certs, err := g.gcpr.ListSSLCertificates(ctx, g.listFilter(resourceType, noFilter))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list SSL certificates from reader")
 	}
@@ -218,36 +922,122 @@ func computeSSLCertificate(ctx context.Context, g *google, resourceType string,
 	return resources, nil
 }
 
+func computeRegionSSLCertificate(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	certs, err := g.gcpr.ListRegionSSLCertificates(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list region SSL certificates from reader")
+	}
+	resources := make([]provider.Resource, 0, len(certs))
+	for _, cert := range certs {
+		r := provider.NewResource(fmt.Sprintf("projects/%s/regions/%s/sslCertificates/%s", g.Project(), g.Region(), cert.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func computeRegionTargetHTTPProxy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	targets, err := g.gcpr.ListRegionTargetHTTPProxies(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list region target http proxies from reader")
+	}
+	resources := make([]provider.Resource, 0, len(targets))
+	for _, target := range targets {
+		r := provider.NewResource(fmt.Sprintf("projects/%s/regions/%s/targetHttpProxies/%s", g.Project(), g.Region(), target.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func computeRegionTargetHTTPSProxy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	targets, err := g.gcpr.ListRegionTargetHTTPSProxies(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list region target https proxies from reader")
+	}
+	resources := make([]provider.Resource, 0, len(targets))
+	for _, target := range targets {
+		r := provider.NewResource(fmt.Sprintf("projects/%s/regions/%s/targetHttpsProxies/%s", g.Project(), g.Region(), target.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func computeRegionURLMap(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	maps, err := g.gcpr.ListRegionURLMaps(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list region URL maps from reader")
+	}
+	resources := make([]provider.Resource, 0, len(maps))
+	for _, urlMap := range maps {
+		r := provider.NewResource(fmt.Sprintf("projects/%s/regions/%s/urlMaps/%s", g.Project(), g.Region(), urlMap.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
 func computeGlobalForwardingRule(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	f := initializeFilter(filters)
+	f := g.listFilter(resourceType, initializeFilter(filters))
 	rules, err := g.gcpr.ListGlobalForwardingRules(ctx, f)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list global forwarding rules from reader")
 	}
 	resources := make([]provider.Resource, 0)
 	for _, rule := range rules {
+		if !matchNetwork(filters, rule.Network) {
+			continue
+		}
 		r := provider.NewResource(rule.Name, resourceType, g)
+		// we set the target and protocol prior of reading them from the
+		// state so they can be used by the Options.ResourceFilter
+		if err := setForwardingRuleData(r, rule); err != nil {
+			return nil, errors.Wrapf(err, "unable to set the target data on the provider.Resource for the forwarding rule '%s'", rule.Name)
+		}
 		resources = append(resources, r)
 	}
 	return resources, nil
 }
 
 func computeForwardingRule(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	f := initializeFilter(filters)
+	f := g.listFilter(resourceType, initializeFilter(filters))
 	rules, err := g.gcpr.ListForwardingRules(ctx, f)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list global forwarding rules from reader")
 	}
 	resources := make([]provider.Resource, 0)
 	for _, rule := range rules {
+		if !matchNetwork(filters, rule.Network) {
+			continue
+		}
 		r := provider.NewResource(rule.Name, resourceType, g)
+		// we set the target and protocol prior of reading them from the
+		// state so they can be used by the Options.ResourceFilter
+		if err := setForwardingRuleData(r, rule); err != nil {
+			return nil, errors.Wrapf(err, "unable to set the target data on the provider.Resource for the forwarding rule '%s'", rule.Name)
+		}
 		resources = append(resources, r)
 	}
 	return resources, nil
 }
 
+// setForwardingRuleData sets the target and the protocol of the
+// rule on the r. The classic VPNs have 3 rules (ESP, UDP 500 and
+// UDP 4500) with the same target, the VPN gateway, which are only
+// told apart by the protocol and the port range
+func setForwardingRuleData(r provider.Resource, rule compute.ForwardingRule) error {
+	data := map[string]interface{}{
+		"target":      rule.Target,
+		"ip_protocol": rule.IPProtocol,
+		"port_range":  rule.PortRange,
+	}
+	for k, v := range data {
+		if err := r.Data().Set(k, v); err != nil {
+			return errors.Wrapf(err, "unable to set %s", k)
+		}
+	}
+	return nil
+}
+
 func computeDisk(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	f := initializeFilter(filters)
+	f := g.listFilter(resourceType, initializeFilter(filters)+initializeStatusFilter(ComputeDisk, filters))
 	disksList, err := g.gcpr.ListDisks(ctx, f)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list disks from reader")
@@ -255,63 +1045,933 @@ func computeDisk(ctx context.Context, g *google, resourceType string, filters *f
 	resources := make([]provider.Resource, 0)
 	for z, disks := range disksList {
 		for _, disk := range disks {
+			if !matchStatus(ComputeDisk, filters, disk.Status) {
+				continue
+			}
 			r := provider.NewResource(fmt.Sprintf("%s/%s", z, disk.Name), resourceType, g)
+			if err := setComputeDiskData(r, disk); err != nil {
+				return nil, errors.Wrapf(err, "unable to set data of disk %s", disk.Name)
+			}
 			resources = append(resources, r)
 		}
 	}
 	return resources, nil
 }
 
-func storageBucket(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	buckets, err := g.gcpr.ListBuckets(ctx)
+// setComputeDiskData sets the image or snapshot from which
+// the disk was created, so it's created the same way from
+// the HCL. The resource policies attached to it are imported
+// as google_compute_disk_resource_policy_attachment
+func setComputeDiskData(r provider.Resource, disk compute.Disk) error {
+	data := map[string]interface{}{
+		"image":    disk.SourceImage,
+		"snapshot": disk.SourceSnapshot,
+	}
+	for k, v := range data {
+		if err := r.Data().Set(k, v); err != nil {
+			return errors.Wrapf(err, "unable to set %s", k)
+		}
+	}
+	return nil
+}
+
+func computeResourcePolicy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	policies, err := g.gcpr.ListResourcePolicies(ctx, g.listFilter(resourceType, noFilter))
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to list global forwarding rules from reader")
+		return nil, errors.Wrap(err, "unable to list resource policies from reader")
 	}
-	resources := make([]provider.Resource, 0)
-	for _, bucket := range buckets {
-		r := provider.NewResource(bucket.Name, resourceType, g)
+	resources := make([]provider.Resource, 0, len(policies))
+	for _, policy := range policies {
+		r := provider.NewResource(fmt.Sprintf("projects/%s/regions/%s/resourcePolicies/%s", g.Project(), g.Region(), policy.Name), resourceType, g)
 		resources = append(resources, r)
 	}
 	return resources, nil
 }
 
-func sqlDatabaseInstance(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	instances, err := g.gcpr.ListStorageInstances(ctx, noFilter)
+// computeDiskResourcePolicyAttachment will import the resource policies attached
+// to the disks. We need to iterate over the disk list
+func computeDiskResourcePolicyAttachment(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	f := g.listFilter(resourceType, initializeFilter(filters))
+	disksList, err := g.gcpr.ListDisks(ctx, f)
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to list sql storage instances rules from reader")
+		return nil, errors.Wrap(err, "unable to list disks from reader")
 	}
 	resources := make([]provider.Resource, 0)
-	for _, instance := range instances {
-		r := provider.NewResource(instance.Name, resourceType, g)
-		resources = append(resources, r)
+	for z, disks := range disksList {
+		for _, disk := range disks {
+			// The disk.ResourcePolicies are URLs with the format:
+			// https://www.googleapis.com/compute/v1/projects/<project>/regions/<region>/resourcePolicies/<name>
+			for _, policy := range disk.ResourcePolicies {
+				parts := strings.Split(policy, "/")
+				r := provider.NewResource(fmt.Sprintf("%s/%s/%s/%s", g.Project(), z, disk.Name, parts[len(parts)-1]), resourceType, g)
+				resources = append(resources, r)
+			}
+		}
 	}
 	return resources, nil
 }
 
-func managedZoneDNS(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	zones, err := g.gcpr.ListManagedZones(ctx)
+// computeAttachedDisk will import the disks attached to the instances,
+// except the boot ones as they are part of the instance. We need to
+// iterate over the instance list
+func computeAttachedDisk(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	f := g.listFilter(resourceType, initializeFilter(filters))
+	instancesList, err := g.gcpr.ListInstances(ctx, f)
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to list DNS managed zone from reader")
+		return nil, errors.Wrap(err, "unable to list instances from reader")
 	}
 	resources := make([]provider.Resource, 0)
-	for _, zone := range zones {
-		r := provider.NewResource(zone.Name, resourceType, g)
-		resources = append(resources, r)
+	for z, instances := range instancesList {
+		for _, instance := range instances {
+			for _, disk := range instance.Disks {
+				if disk.Boot {
+					continue
+				}
+				// The disk.Source is an URL with the format:
+				// https://www.googleapis.com/compute/v1/projects/<project>/zones/<zone>/disks/<name>
+				parts := strings.Split(disk.Source, "/")
+				r := provider.NewResource(fmt.Sprintf("%s/%s/%s/%s", g.Project(), z, instance.Name, parts[len(parts)-1]), resourceType, g)
+				resources = append(resources, r)
+			}
+		}
 	}
 	return resources, nil
 }
 
-func recordSetDNS(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	managedZones, err := managedZoneDNS(ctx, g, resourceType, filters)
+func computeNodeTemplate(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	templates, err := g.gcpr.ListNodeTemplates(ctx, g.listFilter(resourceType, noFilter))
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to previously fetch managed zones")
+		return nil, errors.Wrap(err, "unable to list node templates from reader")
 	}
-	zones := make([]string, 0, len(managedZones))
-	for _, zone := range managedZones {
-		zones = append(zones, zone.ID())
+	resources := make([]provider.Resource, 0, len(templates))
+	for _, template := range templates {
+		r := provider.NewResource(fmt.Sprintf("projects/%s/regions/%s/nodeTemplates/%s", g.Project(), g.Region(), template.Name), resourceType, g)
+		resources = append(resources, r)
 	}
-	rrsetsList, err := g.gcpr.ListResourceRecordSets(ctx, zones)
-	if err != nil {
+	return resources, nil
+}
+
+func computeNodeGroup(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	nodeGroups, err := g.gcpr.ListNodeGroups(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list node groups from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for z, groups := range nodeGroups {
+		for _, group := range groups {
+			r := provider.NewResource(fmt.Sprintf("projects/%s/zones/%s/nodeGroups/%s", g.Project(), z, group.Name), resourceType, g)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+func computeGlobalNetworkEndpointGroup(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	negs, err := g.gcpr.ListGlobalNetworkEndpointGroups(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list global network endpoint groups from reader")
+	}
+	resources := make([]provider.Resource, 0, len(negs))
+	for _, neg := range negs {
+		if !matchNetwork(filters, neg.Network) {
+			continue
+		}
+		r := provider.NewResource(fmt.Sprintf("projects/%s/global/networkEndpointGroups/%s", g.Project(), neg.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func computeRegionNetworkEndpointGroup(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	negs, err := g.gcpr.ListRegionNetworkEndpointGroups(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list region network endpoint groups from reader")
+	}
+	resources := make([]provider.Resource, 0, len(negs))
+	for _, neg := range negs {
+		if !matchNetwork(filters, neg.Network) {
+			continue
+		}
+		r := provider.NewResource(fmt.Sprintf("projects/%s/regions/%s/networkEndpointGroups/%s", g.Project(), g.Region(), neg.Name), resourceType, g)
+		if err := setRegionNetworkEndpointGroupData(r, neg); err != nil {
+			return nil, errors.Wrapf(err, "unable to set data of network endpoint group %s", neg.Name)
+		}
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// setRegionNetworkEndpointGroupData sets the backing service of the
+// serverless NEGs (Cloud Run, App Engine or Cloud Function) so the
+// NEG references the service it routes to
+func setRegionNetworkEndpointGroupData(r provider.Resource, neg compute.NetworkEndpointGroup) error {
+	data := map[string]interface{}{
+		"network_endpoint_type": neg.NetworkEndpointType,
+	}
+	switch {
+	case neg.CloudRun != nil:
+		data["cloud_run"] = []interface{}{map[string]interface{}{
+			"service":  neg.CloudRun.Service,
+			"tag":      neg.CloudRun.Tag,
+			"url_mask": neg.CloudRun.UrlMask,
+		}}
+	case neg.AppEngine != nil:
+		data["app_engine"] = []interface{}{map[string]interface{}{
+			"service":  neg.AppEngine.Service,
+			"version":  neg.AppEngine.Version,
+			"url_mask": neg.AppEngine.UrlMask,
+		}}
+	case neg.CloudFunction != nil:
+		data["cloud_function"] = []interface{}{map[string]interface{}{
+			"function": neg.CloudFunction.Function,
+			"url_mask": neg.CloudFunction.UrlMask,
+		}}
+	}
+	for k, v := range data {
+		if err := r.Data().Set(k, v); err != nil {
+			return errors.Wrapf(err, "unable to set %s", k)
+		}
+	}
+	return nil
+}
+
+func computeInterconnectAttachment(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	attachments, err := g.gcpr.ListInterconnectAttachments(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list interconnect attachments from reader")
+	}
+	resources := make([]provider.Resource, 0, len(attachments))
+	for _, attachment := range attachments {
+		r := provider.NewResource(fmt.Sprintf("projects/%s/regions/%s/interconnectAttachments/%s", g.Project(), g.Region(), attachment.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func computeExternalVPNGateway(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	f := g.listFilter(resourceType, initializeFilter(filters))
+	gateways, err := g.gcpr.ListExternalVPNGateways(ctx, f)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list external vpn gateways from reader")
+	}
+	resources := make([]provider.Resource, 0, len(gateways))
+	for _, gateway := range gateways {
+		r := provider.NewResource(fmt.Sprintf("projects/%s/global/externalVpnGateways/%s", g.Project(), gateway.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func computeReservation(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	reservations, err := g.gcpr.ListReservations(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list reservations from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for z, zoneReservations := range reservations {
+		for _, reservation := range zoneReservations {
+			r := provider.NewResource(fmt.Sprintf("projects/%s/zones/%s/reservations/%s", g.Project(), z, reservation.Name), resourceType, g)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+func computeSSLPolicy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	policies, err := g.gcpr.ListSSLPolicies(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list ssl policies from reader")
+	}
+	resources := make([]provider.Resource, 0, len(policies))
+	for _, policy := range policies {
+		r := provider.NewResource(fmt.Sprintf("projects/%s/global/sslPolicies/%s", g.Project(), policy.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func computeTargetSSLProxy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	targets, err := g.gcpr.ListTargetSSLProxies(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list target ssl proxies from reader")
+	}
+	resources := make([]provider.Resource, 0, len(targets))
+	for _, target := range targets {
+		r := provider.NewResource(fmt.Sprintf("projects/%s/global/targetSslProxies/%s", g.Project(), target.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func computeTargetTCPProxy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	targets, err := g.gcpr.ListTargetTCPProxies(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list target tcp proxies from reader")
+	}
+	resources := make([]provider.Resource, 0, len(targets))
+	for _, target := range targets {
+		r := provider.NewResource(fmt.Sprintf("projects/%s/global/targetTcpProxies/%s", g.Project(), target.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func computeTargetInstance(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	targetsList, err := g.gcpr.ListTargetInstances(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list target instances from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for z, targets := range targetsList {
+		for _, target := range targets {
+			// The backing VM is read by TF from the target
+			// so it's linked to the imported instance
+			r := provider.NewResource(fmt.Sprintf("projects/%s/zones/%s/targetInstances/%s", g.Project(), z, target.Name), resourceType, g)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+func computeTargetGRPCProxy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	targets, err := g.gcpr.ListTargetGRPCProxies(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list target gRPC proxies from reader")
+	}
+	resources := make([]provider.Resource, 0, len(targets))
+	for _, target := range targets {
+		r := provider.NewResource(fmt.Sprintf("projects/%s/global/targetGrpcProxies/%s", g.Project(), target.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func computeProjectMetadata(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	project, err := g.gcpr.GetComputeProject(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get compute project from reader")
+	}
+	// Without metadata there is nothing to import
+	if project.CommonInstanceMetadata == nil || len(project.CommonInstanceMetadata.Items) == 0 {
+		return nil, nil
+	}
+	// It's a singleton of the project so the ID is the project
+	return []provider.Resource{
+		provider.NewResource(g.Project(), resourceType, g),
+	}, nil
+}
+
+func computeProjectDefaultNetworkTier(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	project, err := g.gcpr.GetComputeProject(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get compute project from reader")
+	}
+	if project.DefaultNetworkTier == "" {
+		return nil, nil
+	}
+	// It's a singleton of the project so the ID is the project
+	return []provider.Resource{
+		provider.NewResource(g.Project(), resourceType, g),
+	}, nil
+}
+
+// cloudSchedulerJob imports the jobs of the location matching the
+// Provider region, as Cloud Scheduler uses the App Engine locations
+func cloudSchedulerJob(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	jobs, err := g.gcpr.ListCloudSchedulerJobs(ctx, g.Region())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list cloud scheduler jobs from reader")
+	}
+	resources := make([]provider.Resource, 0, len(jobs))
+	for _, job := range jobs {
+		// The job.Name is already on the format
+		// projects/<project>/locations/<region>/jobs/<name>
+		r := provider.NewResource(job.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func cloudTasksQueue(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	queues, err := g.gcpr.ListCloudTasksQueues(ctx, g.Region())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list cloud tasks queues from reader")
+	}
+	resources := make([]provider.Resource, 0, len(queues))
+	for _, queue := range queues {
+		// The queue.Name is already on the format
+		// projects/<project>/locations/<location>/queues/<name>
+		r := provider.NewResource(queue.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// workflowsWorkflow imports the workflows of the location matching the Provider region
+func workflowsWorkflow(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	wfs, err := g.gcpr.ListWorkflows(ctx, g.Region())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list workflows from reader")
+	}
+	resources := make([]provider.Resource, 0, len(wfs))
+	for _, wf := range wfs {
+		if !matchLabels(wf.Labels, filters) {
+			continue
+		}
+		// The wf.Name is already on the format
+		// projects/<project>/locations/<region>/workflows/<name>
+		r := provider.NewResource(wf.Name, resourceType, g)
+
+		// TODO this resource is not importable. Define our own ResourceImporter
+		// Should be removed when the TF provider will support it
+		r.SetImporter(&schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(d.Id(), "/")
+				if len(parts) != 6 {
+					return nil, fmt.Errorf("unexpected format of ID (%s), expected projects/<project>/locations/<region>/workflows/<name>", d.Id())
+				}
+				d.Set("project", parts[1])
+				d.Set("region", parts[3])
+				d.Set("name", parts[5])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		})
+
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// eventarcTrigger imports the triggers of the location matching the Provider region
+func eventarcTrigger(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	triggers, err := g.gcpr.ListEventarcTriggers(ctx, g.Region())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list eventarc triggers from reader")
+	}
+	resources := make([]provider.Resource, 0, len(triggers))
+	for _, trigger := range triggers {
+		if !matchLabels(trigger.Labels, filters) {
+			continue
+		}
+		// The trigger.Name is already on the format
+		// projects/<project>/locations/<location>/triggers/<name>
+		r := provider.NewResource(trigger.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func composerEnvironment(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	envs, err := g.gcpr.ListComposerEnvironments(ctx, g.Region())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list composer environments from reader")
+	}
+	resources := make([]provider.Resource, 0, len(envs))
+	for _, env := range envs {
+		if !matchLabels(env.Labels, filters) {
+			continue
+		}
+		// The env.Name is already on the format
+		// projects/<project>/locations/<region>/environments/<name>
+		r := provider.NewResource(env.Name, resourceType, g)
+		if err := setComposerEnvironmentData(r, env); err != nil {
+			return nil, errors.Wrapf(err, "unable to set data of composer environment %s", env.Name)
+		}
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// setComposerEnvironmentData sets the software and node
+// config of the env, which define the Airflow version and
+// packages and where its workers run
+func setComposerEnvironmentData(r provider.Resource, env composer.Environment) error {
+	data := map[string]interface{}{
+		"labels": env.Labels,
+	}
+	if cfg := env.Config; cfg != nil {
+		config := map[string]interface{}{
+			"node_count": cfg.NodeCount,
+		}
+		if sc := cfg.SoftwareConfig; sc != nil {
+			config["software_config"] = []interface{}{map[string]interface{}{
+				"image_version":            sc.ImageVersion,
+				"python_version":           sc.PythonVersion,
+				"airflow_config_overrides": sc.AirflowConfigOverrides,
+				"pypi_packages":            sc.PypiPackages,
+				"env_variables":            sc.EnvVariables,
+			}}
+		}
+		if nc := cfg.NodeConfig; nc != nil {
+			config["node_config"] = []interface{}{map[string]interface{}{
+				"zone":            nc.Location,
+				"machine_type":    nc.MachineType,
+				"network":         nc.Network,
+				"subnetwork":      nc.Subnetwork,
+				"disk_size_gb":    nc.DiskSizeGb,
+				"service_account": nc.ServiceAccount,
+				"oauth_scopes":    nc.OauthScopes,
+				"tags":            nc.Tags,
+			}}
+		}
+		data["config"] = []interface{}{config}
+	}
+	for k, v := range data {
+		if err := r.Data().Set(k, v); err != nil {
+			return errors.Wrapf(err, "unable to set %s", k)
+		}
+	}
+	return nil
+}
+
+func osConfigPatchDeployment(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	deployments, err := g.gcpr.ListOSConfigPatchDeployments(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list os config patch deployments from reader")
+	}
+	resources := make([]provider.Resource, 0, len(deployments))
+	for _, deployment := range deployments {
+		// The deployment.Name is already on the format
+		// projects/<project>/patchDeployments/<name>
+		r := provider.NewResource(deployment.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func filestoreInstance(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	f := g.listFilter(resourceType, initializeFilter(filters))
+	// Filestore instances are located on a zone
+	zones, err := g.gcpr.getZones()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list zones from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, z := range zones {
+		instances, err := g.gcpr.ListFilestoreInstances(ctx, z, f)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list filestore instances from reader")
+		}
+		for _, instance := range instances {
+			if !matchStatus(FilestoreInstance, filters, instance.State) {
+				continue
+			}
+			// The instance.Name is already on the format
+			// projects/<project>/locations/<location>/instances/<name>
+			r := provider.NewResource(instance.Name, resourceType, g)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+// pubsubTopicIAMPolicy will import the policies binded to a topic. We need to iterate over the
+// topic list
+func pubsubTopicIAMPolicy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	topics, err := g.gcpr.ListPubSubTopics(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list pubsub topics from reader")
+	}
+	resources := make([]provider.Resource, 0, len(topics))
+	for _, topic := range topics {
+		// The topic.Name is already on the format
+		// projects/<project>/topics/<name>
+		r := provider.NewResource(topic.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func loggingProjectSink(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	sinks, err := g.gcpr.ListLoggingSinks(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list logging sinks from reader")
+	}
+	resources := make([]provider.Resource, 0, len(sinks))
+	for _, sink := range sinks {
+		// The _Required sink is created by Google
+		// and it can not be modified or deleted
+		if sink.Name == "_Required" {
+			continue
+		}
+		r := provider.NewResource(fmt.Sprintf("projects/%s/sinks/%s", g.Project(), sink.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func loggingMetric(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	metrics, err := g.gcpr.ListLoggingMetrics(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list logging metrics from reader")
+	}
+	resources := make([]provider.Resource, 0, len(metrics))
+	for _, metric := range metrics {
+		// The metric name can have '/' so the
+		// import ID format is '<project> <name>'
+		r := provider.NewResource(fmt.Sprintf("%s %s", g.Project(), metric.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func monitoringAlertPolicy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	policies, err := g.gcpr.ListMonitoringAlertPolicies(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list monitoring alert policies from reader")
+	}
+	resources := make([]provider.Resource, 0, len(policies))
+	for _, policy := range policies {
+		// The policy.Name is already on the format
+		// projects/<project>/alertPolicies/<id>
+		r := provider.NewResource(policy.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func monitoringNotificationChannel(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	channels, err := g.gcpr.ListMonitoringNotificationChannels(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list monitoring notification channels from reader")
+	}
+	resources := make([]provider.Resource, 0, len(channels))
+	for _, channel := range channels {
+		// The channel.Name is already on the format
+		// projects/<project>/notificationChannels/<id>
+		r := provider.NewResource(channel.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func dataprocCluster(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	clusters, err := g.gcpr.ListDataprocClusters(ctx, g.Region(), initializeDataprocFilter(filters))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list dataproc clusters from reader")
+	}
+	resources := make([]provider.Resource, 0, len(clusters))
+	for _, cluster := range clusters {
+		var state string
+		if cluster.Status != nil {
+			state = cluster.Status.State
+		}
+		if !matchStatus(DataprocCluster, filters, state) {
+			continue
+		}
+		r := provider.NewResource(fmt.Sprintf("projects/%s/regions/%s/clusters/%s", g.Project(), g.Region(), cluster.ClusterName), resourceType, g)
+
+		// TODO this resource is not importable. Define our own ResourceImporter
+		// Should be removed when the TF provider will support it
+		r.SetImporter(&schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(d.Id(), "/")
+				if len(parts) != 6 {
+					return nil, fmt.Errorf("unexpected format of ID (%s), expected projects/<project>/regions/<region>/clusters/<name>", d.Id())
+				}
+				d.Set("project", parts[1])
+				d.Set("region", parts[3])
+				d.Set("name", parts[5])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		})
+
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func iapBrand(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	brands, err := g.gcpr.ListIAPBrands(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list iap brands from reader")
+	}
+	resources := make([]provider.Resource, 0, len(brands))
+	for _, brand := range brands {
+		// The brand.Name is already on the format
+		// projects/<project-number>/brands/<id>
+		r := provider.NewResource(brand.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// iapWebIAMPolicy imports the IAP policy of the project
+func iapWebIAMPolicy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	return []provider.Resource{
+		provider.NewResource(fmt.Sprintf("projects/%s/iap_web", g.Project()), resourceType, g),
+	}, nil
+}
+
+// iapWebBackendServiceIAMPolicy will import the IAP policies binded to a backend service.
+// We need to iterate over the backend service list
+func iapWebBackendServiceIAMPolicy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	backends, err := g.gcpr.ListBackendServices(ctx, noFilter)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list backend services from reader")
+	}
+	resources := make([]provider.Resource, 0, len(backends))
+	for _, backend := range backends {
+		r := provider.NewResource(fmt.Sprintf("projects/%s/iap_web/compute/services/%s", g.Project(), backend.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// cloudIdentityGroup imports the groups of the Options.Customer
+func cloudIdentityGroup(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	if g.options.Customer == "" {
+		return nil, nil
+	}
+	groups, err := g.gcpr.ListCloudIdentityGroups(ctx, g.options.Customer)
+	if err != nil {
+		if permissionDenied(err) {
+			return nil, &PermissionError{ResourceType: resourceType, On: fmt.Sprintf("the customer %s", g.options.Customer), Skipped: true, Err: err}
+		}
+		return nil, errors.Wrap(err, "unable to list cloud identity groups from reader")
+	}
+	resources := make([]provider.Resource, 0, len(groups))
+	for _, group := range groups {
+		// The group.Name is already on the format
+		// groups/<id>
+		r := provider.NewResource(group.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// cloudIdentityGroupMembership imports the memberships of the groups
+// of the Options.Customer. We need to iterate over the group list
+func cloudIdentityGroupMembership(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	groups, err := cloudIdentityGroup(ctx, g, resourceType, filters)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(groups))
+	for _, group := range groups {
+		names = append(names, group.ID())
+	}
+	membershipsList, err := g.gcpr.ListCloudIdentityGroupMemberships(ctx, names)
+	if err != nil {
+		if permissionDenied(err) {
+			return nil, &PermissionError{ResourceType: resourceType, On: fmt.Sprintf("the customer %s", g.options.Customer), Skipped: true, Err: err}
+		}
+		return nil, errors.Wrap(err, "unable to list cloud identity group memberships from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, memberships := range membershipsList {
+		for _, membership := range memberships {
+			// The membership.Name is already on the format
+			// groups/<id>/memberships/<id>
+			r := provider.NewResource(membership.Name, resourceType, g)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+// appEngineApplication imports the App Engine Application of the project,
+// as there can be only one per project and it may not exist
+func appEngineApplication(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	_, err := g.gcpr.GetAppEngineApplication(ctx)
+	if err != nil {
+		if notFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "unable to get app engine application from reader")
+	}
+	return []provider.Resource{
+		provider.NewResource(g.Project(), resourceType, g),
+	}, nil
+}
+
+// appEngineServices returns the IDs of the App Engine Services
+// of the project, which are none if there is no Application
+func appEngineServices(ctx context.Context, g *google) ([]string, error) {
+	services, err := g.gcpr.ListAppEngineServices(ctx)
+	if err != nil {
+		if notFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "unable to list app engine services from reader")
+	}
+	ids := make([]string, 0, len(services))
+	for _, service := range services {
+		ids = append(ids, service.Id)
+	}
+	return ids, nil
+}
+
+// appEngineStandardAppVersion imports the versions of the standard
+// environment. We need to iterate over the service list
+func appEngineStandardAppVersion(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	services, err := appEngineServices(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+	versionsList, err := g.gcpr.ListAppEngineVersions(ctx, services)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list app engine versions from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for service, versions := range versionsList {
+		for _, version := range versions {
+			// The Env is empty for the default
+			// environment which is the standard
+			if version.Env != "" && version.Env != "standard" {
+				continue
+			}
+			r := provider.NewResource(fmt.Sprintf("apps/%s/services/%s/versions/%s", g.Project(), service, version.Id), resourceType, g)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+// appEngineServiceSplitTraffic imports the traffic split of each service
+func appEngineServiceSplitTraffic(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	services, err := appEngineServices(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]provider.Resource, 0, len(services))
+	for _, service := range services {
+		r := provider.NewResource(fmt.Sprintf("apps/%s/services/%s", g.Project(), service), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// bigtableInstances returns the names of the Bigtable Instances
+// matching the filters, with the format projects/<project>/instances/<instance>
+func bigtableInstances(ctx context.Context, g *google, filters *filter.Filter) ([]string, error) {
+	instances, err := g.gcpr.ListBigtableInstances(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list bigtable instances from reader")
+	}
+	names := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		if !matchLabels(instance.Labels, filters) {
+			continue
+		}
+		names = append(names, instance.Name)
+	}
+	return names, nil
+}
+
+func bigtableInstance(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	instances, err := bigtableInstances(ctx, g, filters)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]provider.Resource, 0, len(instances))
+	for _, instance := range instances {
+		parts := strings.Split(instance, "/")
+		r := provider.NewResource(fmt.Sprintf("%s/%s", g.Project(), parts[len(parts)-1]), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// bigtableTable imports the tables of the instances. We need
+// to iterate over the instance list
+func bigtableTable(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	instances, err := bigtableInstances(ctx, g, filters)
+	if err != nil {
+		return nil, err
+	}
+	tablesList, err := g.gcpr.ListBigtableTables(ctx, instances)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list bigtable tables from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for instance, tables := range tablesList {
+		iparts := strings.Split(instance, "/")
+		for _, table := range tables {
+			// The table.Name has the format
+			// projects/<project>/instances/<instance>/tables/<table>
+			tparts := strings.Split(table.Name, "/")
+			r := provider.NewResource(fmt.Sprintf("%s/%s/%s", g.Project(), iparts[len(iparts)-1], tparts[len(tparts)-1]), resourceType, g)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+func storageBucket(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	buckets, err := g.gcpr.ListBuckets(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list global forwarding rules from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, bucket := range buckets {
+		r := provider.NewResource(bucket.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func storageTransferJob(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	jobs, err := g.gcpr.ListStorageTransferJobs(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list storage transfer jobs from reader")
+	}
+	resources := make([]provider.Resource, 0, len(jobs))
+	for _, job := range jobs {
+		// The deleted jobs are still listed for
+		// some days after being deleted
+		if job.Status == "DELETED" {
+			continue
+		}
+		// The job.Name has the format transferJobs/<name>
+		r := provider.NewResource(fmt.Sprintf("%s/%s", g.Project(), strings.TrimPrefix(job.Name, "transferJobs/")), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func sqlDatabaseInstance(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	instances, err := g.gcpr.ListStorageInstances(ctx, g.listFilter(resourceType, noFilter))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list sql storage instances rules from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, instance := range instances {
+		if !matchStatus(SQLDatabaseInstance, filters, instance.State) {
+			continue
+		}
+		r := provider.NewResource(instance.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func managedZoneDNS(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	zones, err := g.gcpr.ListManagedZones(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list DNS managed zone from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, zone := range zones {
+		r := provider.NewResource(zone.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func recordSetDNS(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	managedZones, err := managedZoneDNS(ctx, g, resourceType, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to previously fetch managed zones")
+	}
+	zones := make([]string, 0, len(managedZones))
+	for _, zone := range managedZones {
+		zones = append(zones, zone.ID())
+	}
+	rrsetsList, err := g.gcpr.ListResourceRecordSets(ctx, zones)
+	if err != nil {
 		return nil, errors.Wrap(err, "unable to list resources record se record sett from reader")
 	}
 	resources := make([]provider.Resource, 0)
@@ -325,22 +1985,61 @@ func recordSetDNS(ctx context.Context, g *google, resourceType string, filters *
 }
 
 func computeBackendBucket(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	backends, err := g.gcpr.ListBackendBuckets(ctx, noFilter)
+	backends, err := g.gcpr.ListBackendBuckets(ctx, g.listFilter(resourceType, noFilter))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list backend buckets from reader")
 	}
 	resources := make([]provider.Resource, 0, len(backends))
 	for _, backend := range backends {
 		r := provider.NewResource(backend.Name, resourceType, g)
+		if err := setComputeBackendBucketData(r, backend); err != nil {
+			return nil, errors.Wrapf(err, "unable to set data of backend bucket %s", backend.Name)
+		}
 		resources = append(resources, r)
 	}
 	return resources, nil
 }
 
+// setComputeBackendBucketData sets the Cloud CDN configuration
+// of the backend. The cdn_policy of the TF provider 3.67 only
+// has the signed_url_cache_max_age_sec, so the cache mode and
+// TTLs are left to their defaults
+func setComputeBackendBucketData(r provider.Resource, backend compute.BackendBucket) error {
+	data := map[string]interface{}{
+		"enable_cdn": backend.EnableCdn,
+	}
+	if p := backend.CdnPolicy; p != nil {
+		data["cdn_policy"] = []interface{}{
+			map[string]interface{}{
+				"signed_url_cache_max_age_sec": int(p.SignedUrlCacheMaxAgeSec),
+			},
+		}
+	}
+	for k, v := range data {
+		if err := r.Data().Set(k, v); err != nil {
+			return errors.Wrapf(err, "unable to set %s", k)
+		}
+	}
+	return nil
+}
+
 func projectIAMCustomRole(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	roles, err := g.gcpr.ListProjectIAMCustomRoles(ctx, fmt.Sprintf("projects/%s", g.gcpr.project))
+	return iamCustomRole(ctx, g, resourceType, fmt.Sprintf("projects/%s", g.gcpr.project))
+}
+
+// organizationIAMCustomRole imports the custom roles of the Options.Organization
+func organizationIAMCustomRole(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	if g.options.Organization == "" {
+		return nil, nil
+	}
+	return iamCustomRole(ctx, g, resourceType, fmt.Sprintf("organizations/%s", g.options.Organization))
+}
+
+// iamCustomRole imports the custom roles within the parent
+func iamCustomRole(ctx context.Context, g *google, resourceType, parent string) ([]provider.Resource, error) {
+	roles, err := g.gcpr.ListProjectIAMCustomRoles(ctx, parent)
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to list project IAM custom roles from reader")
+		return nil, errors.Wrap(err, "unable to list IAM custom roles from reader")
 	}
 	resources := make([]provider.Resource, 0, len(roles))
 	for _, role := range roles {
@@ -350,6 +2049,287 @@ func projectIAMCustomRole(ctx context.Context, g *google, resourceType string, f
 	return resources, nil
 }
 
+// folderIAMPolicy imports the policy of the Options.Folder
+func folderIAMPolicy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	if g.options.Folder == "" {
+		return nil, nil
+	}
+	return []provider.Resource{
+		provider.NewResource(fmt.Sprintf("folders/%s", g.options.Folder), resourceType, g),
+	}, nil
+}
+
+// billingAccountIAMPolicy imports the policy of the Options.BillingAccount,
+// it's skipped if the credentials have no permissions on it
+func billingAccountIAMPolicy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	if g.options.BillingAccount == "" {
+		return nil, nil
+	}
+	account, err := g.gcpr.GetBillingAccount(ctx, g.options.BillingAccount)
+	if err != nil {
+		if permissionDenied(err) {
+			return nil, &PermissionError{ResourceType: resourceType, On: fmt.Sprintf("the billing account %s", g.options.BillingAccount), Skipped: true, Err: err}
+		}
+		return nil, errors.Wrap(err, "unable to get billing account from reader")
+	}
+	return []provider.Resource{
+		provider.NewResource(strings.TrimPrefix(account.Name, "billingAccounts/"), resourceType, g),
+	}, nil
+}
+
+// tagsTagKey imports the tag keys of the Options.Organization
+func tagsTagKey(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	if g.options.Organization == "" {
+		return nil, nil
+	}
+	keys, err := g.gcpr.ListTagKeys(ctx, fmt.Sprintf("organizations/%s", g.options.Organization))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list tag keys from reader")
+	}
+	resources := make([]provider.Resource, 0, len(keys))
+	for _, key := range keys {
+		// The key.Name has the format tagKeys/<id>
+		r := provider.NewResource(key.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// tagsTagValue imports the values of the tag keys of the
+// Options.Organization. We need to iterate over the key list
+func tagsTagValue(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	if g.options.Organization == "" {
+		return nil, nil
+	}
+	keys, err := g.gcpr.ListTagKeys(ctx, fmt.Sprintf("organizations/%s", g.options.Organization))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list tag keys from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, key := range keys {
+		values, err := g.gcpr.ListTagValues(ctx, key.Name)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list tag values from reader")
+		}
+		for _, value := range values {
+			// The value.Name has the format tagValues/<id>
+			r := provider.NewResource(value.Name, resourceType, g)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+// tagsTagBinding imports the tag values bound to the project
+func tagsTagBinding(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	number, err := g.gcpr.GetProjectNumber(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get the project number from reader")
+	}
+	bindings, err := g.gcpr.ListTagBindings(ctx, fmt.Sprintf("//cloudresourcemanager.googleapis.com/projects/%d", number))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list tag bindings from reader")
+	}
+	resources := make([]provider.Resource, 0, len(bindings))
+	for _, binding := range bindings {
+		// The binding.Name has the format tagBindings/<escaped parent>/tagValues/<id>
+		r := provider.NewResource(binding.Name, resourceType, g)
+
+		// TODO the importer of the TF provider does not set the parent,
+		// which is needed to read the binding. Define our own ResourceImporter
+		// Should be removed when the TF provider will support it
+		r.SetImporter(&schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(d.Id(), "/")
+				if len(parts) != 4 {
+					return nil, fmt.Errorf("unexpected format of ID (%s), expected tagBindings/<escaped parent>/tagValues/<id>", d.Id())
+				}
+				parent, err := url.PathUnescape(parts[1])
+				if err != nil {
+					return nil, fmt.Errorf("invalid parent on the ID (%s): %w", d.Id(), err)
+				}
+				name := strings.TrimPrefix(d.Id(), "tagBindings/")
+				d.Set("name", name)
+				d.Set("parent", parent)
+				d.SetId(name)
+				return []*schema.ResourceData{d}, nil
+			},
+		})
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// accessPolicies returns the names (accessPolicies/<id>) of the
+// Options.AccessPolicy or of the policies of the Options.Organization
+func accessPolicies(ctx context.Context, g *google) ([]string, error) {
+	if g.options.AccessPolicy != "" {
+		return []string{fmt.Sprintf("accessPolicies/%s", g.options.AccessPolicy)}, nil
+	}
+	if g.options.Organization == "" {
+		return nil, nil
+	}
+	policies, err := g.gcpr.ListAccessPolicies(ctx, fmt.Sprintf("organizations/%s", g.options.Organization))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		names = append(names, policy.Name)
+	}
+	return names, nil
+}
+
+// accessContextManagerAccessLevel imports the access levels of the
+// access policies, it's skipped if the credentials have no
+// permissions on them as it needs organization level access
+func accessContextManagerAccessLevel(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	policies, err := accessPolicies(ctx, g)
+	if err != nil {
+		if permissionDenied(err) {
+			return nil, &PermissionError{ResourceType: resourceType, On: fmt.Sprintf("the organization %s", g.options.Organization), Skipped: true, Err: err}
+		}
+		return nil, errors.Wrap(err, "unable to list access policies from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, policy := range policies {
+		levels, err := g.gcpr.ListAccessLevels(ctx, policy)
+		if err != nil {
+			if permissionDenied(err) {
+				return nil, &PermissionError{ResourceType: resourceType, On: fmt.Sprintf("the access policy %s", policy), Skipped: true, Err: err}
+			}
+			return nil, errors.Wrap(err, "unable to list access levels from reader")
+		}
+		for _, level := range levels {
+			// The level.Name has the format
+			// accessPolicies/<id>/accessLevels/<name>
+			r := provider.NewResource(level.Name, resourceType, g)
+			if err := setAccessContextManagerData(r, level.Name, policy, level.Title, nil); err != nil {
+				return nil, errors.Wrapf(err, "unable to set data of access level %s", level.Name)
+			}
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+// accessContextManagerServicePerimeter imports the service perimeters
+// of the access policies, it's skipped if the credentials have no
+// permissions on them as it needs organization level access
+func accessContextManagerServicePerimeter(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	policies, err := accessPolicies(ctx, g)
+	if err != nil {
+		if permissionDenied(err) {
+			return nil, &PermissionError{ResourceType: resourceType, On: fmt.Sprintf("the organization %s", g.options.Organization), Skipped: true, Err: err}
+		}
+		return nil, errors.Wrap(err, "unable to list access policies from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, policy := range policies {
+		perimeters, err := g.gcpr.ListServicePerimeters(ctx, policy)
+		if err != nil {
+			if permissionDenied(err) {
+				return nil, &PermissionError{ResourceType: resourceType, On: fmt.Sprintf("the access policy %s", policy), Skipped: true, Err: err}
+			}
+			return nil, errors.Wrap(err, "unable to list service perimeters from reader")
+		}
+		for _, perimeter := range perimeters {
+			// The perimeter.Name has the format
+			// accessPolicies/<id>/servicePerimeters/<name>
+			r := provider.NewResource(perimeter.Name, resourceType, g)
+			data := map[string]interface{}{
+				"perimeter_type": perimeter.PerimeterType,
+			}
+			if st := perimeter.Status; st != nil {
+				data["status"] = []interface{}{map[string]interface{}{
+					"restricted_services": st.RestrictedServices,
+					"resources":           st.Resources,
+					"access_levels":       st.AccessLevels,
+				}}
+			}
+			if err := setAccessContextManagerData(r, perimeter.Name, policy, perimeter.Title, data); err != nil {
+				return nil, errors.Wrapf(err, "unable to set data of service perimeter %s", perimeter.Name)
+			}
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+// setAccessContextManagerData sets the name, parent and title
+// of the access levels and service perimeters with the extra data
+func setAccessContextManagerData(r provider.Resource, name, parent, title string, data map[string]interface{}) error {
+	if data == nil {
+		data = make(map[string]interface{})
+	}
+	data["name"] = name
+	data["parent"] = parent
+	data["title"] = title
+	for k, v := range data {
+		if err := r.Data().Set(k, v); err != nil {
+			return errors.Wrapf(err, "unable to set %s", k)
+		}
+	}
+	return nil
+}
+
+// binaryAuthorizationPolicy imports the Binary Authorization policy
+// of the project, which is a singleton and only exists once it has
+// been set
+func binaryAuthorizationPolicy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	_, err := g.gcpr.GetBinaryAuthorizationPolicy(ctx)
+	if err != nil {
+		if notFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "unable to get binary authorization policy from reader")
+	}
+	// It's a singleton of the project so the ID is the project
+	return []provider.Resource{
+		provider.NewResource(g.Project(), resourceType, g),
+	}, nil
+}
+
+func binaryAuthorizationAttestor(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	attestors, err := g.gcpr.ListBinaryAuthorizationAttestors(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list binary authorization attestors from reader")
+	}
+	resources := make([]provider.Resource, 0, len(attestors))
+	for _, attestor := range attestors {
+		// The attestor.Name is already on the format
+		// projects/<project>/attestors/<name>
+		r := provider.NewResource(attestor.Name, resourceType, g)
+		if err := setBinaryAuthorizationAttestorData(r, attestor); err != nil {
+			return nil, errors.Wrapf(err, "unable to set data of binary authorization attestor %s", attestor.Name)
+		}
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// setBinaryAuthorizationAttestorData sets the Container Analysis
+// note of the attestor, on which its attestations are stored, so
+// it's kept even if it's on another project
+func setBinaryAuthorizationAttestorData(r provider.Resource, attestor binaryauthorization.Attestor) error {
+	parts := strings.Split(attestor.Name, "/")
+	data := map[string]interface{}{
+		"name":        parts[len(parts)-1],
+		"description": attestor.Description,
+	}
+	if note := attestor.UserOwnedGrafeasNote; note != nil {
+		data["attestation_authority_note"] = []interface{}{map[string]interface{}{
+			"note_reference": note.NoteReference,
+		}}
+	}
+	for k, v := range data {
+		if err := r.Data().Set(k, v); err != nil {
+			return errors.Wrapf(err, "unable to set %s", k)
+		}
+	}
+	return nil
+}
+
 // storageBucketIAMPolicy will import the policies binded to a bucket. We need to iterate over the
 // bucket list
 func storageBucketIAMPolicy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
@@ -368,7 +2348,7 @@ func storageBucketIAMPolicy(ctx context.Context, g *google, resourceType string,
 // computeInstanceIAMPolicy will import the policies binded to a compute instance. We need to iterate over the
 // compute instance list
 func computeInstanceIAMPolicy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	f := initializeFilter(filters)
+	f := g.listFilter(resourceType, initializeFilter(filters))
 	list, err := g.gcpr.ListInstances(ctx, f)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list compute instances from reader")
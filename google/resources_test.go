@@ -0,0 +1,941 @@
+package google
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/cycloidio/mxwriter"
+	hcl2 "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfgoogle "github.com/hashicorp/terraform-provider-google/google"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/accesscontextmanager/v1"
+	"google.golang.org/api/binaryauthorization/v1"
+	"google.golang.org/api/cloudbilling/v1"
+	crmv1 "google.golang.org/api/cloudresourcemanager/v1"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/composer/v1"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	"google.golang.org/api/storagetransfer/v1"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/hcl"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/tag"
+	"github.com/cycloidio/terracognita/writer"
+)
+
+func TestListFilter(t *testing.T) {
+	g := &google{
+		options: Options{
+			RawFilters: map[string]string{
+				"google_compute_instance": "status = RUNNING",
+			},
+		},
+	}
+
+	assert.Equal(t, `(status = RUNNING) (labels.env="prod") `, g.listFilter("google_compute_instance", `(labels.env="prod") `))
+	assert.Equal(t, "(status = RUNNING) ", g.listFilter("google_compute_instance", noFilter))
+	assert.Equal(t, `(labels.env="prod") `, g.listFilter("google_compute_disk", `(labels.env="prod") `))
+}
+
+func TestInitializeFilter(t *testing.T) {
+	tcs := []struct {
+		name string
+		tags []tag.Tag
+		exp  string
+	}{
+		{name: "Empty", exp: ""},
+		{name: "Simple", tags: []tag.Tag{{Name: "env", Value: "prod"}}, exp: `(labels.env="prod") `},
+		{name: "Multiple", tags: []tag.Tag{{Name: "env", Value: "prod"}, {Name: "team", Value: "x"}}, exp: `(labels.env="prod") (labels.team="x") `},
+		{name: "Spaces", tags: []tag.Tag{{Name: "owner", Value: "team x (ops)"}}, exp: `(labels.owner="team x (ops)") `},
+		{name: "Quotes", tags: []tag.Tag{{Name: "owner", Value: `say "hi" \o/`}}, exp: `(labels.owner="say \"hi\" \\o/") `},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.exp, initializeFilter(&filter.Filter{Tags: tc.tags}))
+		})
+	}
+}
+
+func TestInitializeDataprocFilter(t *testing.T) {
+	tcs := []struct {
+		name string
+		tags []tag.Tag
+		exp  string
+	}{
+		{name: "Empty", exp: ""},
+		{name: "Simple", tags: []tag.Tag{{Name: "env", Value: "prod"}}, exp: `labels.env = "prod"`},
+		{name: "Multiple", tags: []tag.Tag{{Name: "env", Value: "prod"}, {Name: "team", Value: "x"}}, exp: `labels.env = "prod" AND labels.team = "x"`},
+		{name: "EmptyValue", tags: []tag.Tag{{Name: "env", Value: ""}}, exp: `labels.env = ""`},
+		{name: "Quotes", tags: []tag.Tag{{Name: "owner", Value: `say "hi" \o/`}}, exp: `labels.owner = "say \"hi\" \\o/"`},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.exp, initializeDataprocFilter(&filter.Filter{Tags: tc.tags}))
+		})
+	}
+}
+
+func TestSupportedResourceTypes(t *testing.T) {
+	srts := SupportedResourceTypes()
+	require.Len(t, srts, len(ResourceTypeValues()), "all the ResourceTypes must have a function on the resources")
+	assert.Len(t, resources, len(srts), "all the functions on the resources must have a ResourceType")
+
+	for _, srt := range srts {
+		assert.Equal(t, srt.Type.String(), srt.Name)
+		assert.Contains(t, resources, srt.Type)
+	}
+	for rt := range labelFilterResourceTypes {
+		assert.Contains(t, resources, rt)
+	}
+	for rt := range rawFilterResourceTypes {
+		assert.Contains(t, resources, rt)
+	}
+	for rt := range networkFilterResourceTypes {
+		assert.Contains(t, resources, rt)
+	}
+	for rt := range statusFilterResourceTypes {
+		assert.Contains(t, resources, rt)
+	}
+	for rt := range enrichers {
+		assert.Contains(t, resources, rt)
+	}
+	for rt := range experimentalResourceTypes {
+		assert.Contains(t, resources, rt)
+		assert.True(t, IsExperimental(rt.String()))
+	}
+	assert.False(t, IsExperimental(ComputeInstance.String()))
+}
+
+func TestSetFirewallData(t *testing.T) {
+	g := &google{tfProvider: tfgoogle.Provider()}
+	r := provider.NewResource("allow-http", ComputeFirewall.String(), g)
+
+	err := setFirewallData(r, compute.Firewall{
+		Name: "allow-http",
+		Allowed: []*compute.FirewallAllowed{
+			{IPProtocol: "tcp", Ports: []string{"80", "8080-8090"}},
+			{IPProtocol: "icmp"},
+		},
+		Denied: []*compute.FirewallDenied{
+			{IPProtocol: "udp", Ports: []string{"53"}},
+		},
+		Direction:             "INGRESS",
+		Priority:              900,
+		SourceRanges:          []string{"10.0.0.0/8"},
+		SourceServiceAccounts: []string{"front@project.iam.gserviceaccount.com"},
+		TargetServiceAccounts: []string{"back@project.iam.gserviceaccount.com"},
+	})
+	require.NoError(t, err)
+
+	d := r.Data()
+	assert.ElementsMatch(t, []interface{}{
+		map[string]interface{}{"protocol": "tcp", "ports": []interface{}{"80", "8080-8090"}},
+		map[string]interface{}{"protocol": "icmp", "ports": []interface{}{}},
+	}, d.Get("allow").(*schema.Set).List())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"protocol": "udp", "ports": []interface{}{"53"}},
+	}, d.Get("deny").(*schema.Set).List())
+	assert.Equal(t, "INGRESS", d.Get("direction"))
+	assert.Equal(t, 900, d.Get("priority"))
+	assert.Equal(t, []interface{}{"10.0.0.0/8"}, d.Get("source_ranges").(*schema.Set).List())
+	assert.Equal(t, []interface{}{"front@project.iam.gserviceaccount.com"}, d.Get("source_service_accounts").(*schema.Set).List())
+	assert.Equal(t, []interface{}{"back@project.iam.gserviceaccount.com"}, d.Get("target_service_accounts").(*schema.Set).List())
+	assert.Empty(t, d.Get("target_tags").(*schema.Set).List())
+}
+
+func TestMatchLabels(t *testing.T) {
+	f := &filter.Filter{
+		Tags: []tag.Tag{
+			{Name: "env", Value: "prod"},
+		},
+	}
+
+	assert.True(t, matchLabels(map[string]string{"env": "prod", "team": "a"}, f))
+	assert.False(t, matchLabels(map[string]string{"env": "dev"}, f))
+	assert.False(t, matchLabels(nil, f))
+	assert.True(t, matchLabels(nil, &filter.Filter{}))
+}
+
+func TestMatchNetwork(t *testing.T) {
+	network := "https://www.googleapis.com/compute/v1/projects/my-project/global/networks/vpc-a"
+
+	assert.True(t, matchNetwork(&filter.Filter{}, network))
+	assert.True(t, matchNetwork(&filter.Filter{}))
+	assert.True(t, matchNetwork(&filter.Filter{Network: "vpc-a"}, network))
+	assert.True(t, matchNetwork(&filter.Filter{Network: "projects/my-project/global/networks/vpc-a"}, network))
+	assert.True(t, matchNetwork(&filter.Filter{Network: "vpc-a"}, "https://www.googleapis.com/compute/v1/projects/my-project/global/networks/default", network))
+	assert.False(t, matchNetwork(&filter.Filter{Network: "vpc"}, network))
+	assert.False(t, matchNetwork(&filter.Filter{Network: "vpc-a"}))
+	assert.False(t, matchNetwork(&filter.Filter{Network: "vpc-a"}, ""))
+}
+
+func TestMatchNamePrefix(t *testing.T) {
+	f := &filter.Filter{NamePrefix: "teamx-"}
+
+	assert.True(t, matchNamePrefix(&filter.Filter{}, ComputeInstance, "my-project/europe-west1-b/vm"))
+	assert.True(t, matchNamePrefix(f, ComputeInstance, "my-project/europe-west1-b/teamx-vm"))
+	assert.False(t, matchNamePrefix(f, ComputeInstance, "my-project/europe-west1-b/vm"))
+	assert.False(t, matchNamePrefix(f, ComputeInstance, "teamx-project/europe-west1-b/vm"))
+	assert.True(t, matchNamePrefix(f, ComputeInstanceGroupNamedPort, "projects/my-project/zones/europe-west1-b/instanceGroups/teamx-group/80/http"))
+	assert.True(t, matchNamePrefix(f, ComputeAttachedDisk, "my-project/europe-west1-b/teamx-vm/disk"))
+	assert.True(t, matchNamePrefix(f, DNSRecordSet, "zone/teamx-www.example.com./A"))
+	assert.True(t, matchNamePrefix(f, LoggingMetric, "my-project teamx-metric"))
+	assert.False(t, matchNamePrefix(f, LoggingMetric, "teamx-project metric"))
+	assert.True(t, matchNamePrefix(f, FolderIAMPolicy, "folders/42"))
+}
+
+func TestMatchStatus(t *testing.T) {
+	f := &filter.Filter{Status: []string{"running", "RUNNABLE"}}
+
+	assert.True(t, matchStatus(ComputeInstance, &filter.Filter{}, "TERMINATED"))
+	assert.True(t, matchStatus(ComputeInstance, f, "RUNNING"))
+	assert.False(t, matchStatus(ComputeInstance, f, "TERMINATED"))
+	assert.True(t, matchStatus(SQLDatabaseInstance, f, "RUNNABLE"))
+	assert.False(t, matchStatus(SQLDatabaseInstance, f, "SUSPENDED"))
+	// none of the statuses are valid for the type
+	assert.True(t, matchStatus(ComputeDisk, f, "DELETING"))
+	assert.True(t, matchStatus(ComputeNetwork, f, ""))
+
+	assert.Equal(t, `(status = "RUNNING") `, initializeStatusFilter(ComputeInstance, f))
+	assert.Equal(t, "", initializeStatusFilter(ComputeDisk, f))
+	assert.Equal(t, "", initializeStatusFilter(ComputeInstance, &filter.Filter{Status: []string{"RUNNING", "STAGING"}}))
+}
+
+func TestResourceLocation(t *testing.T) {
+	tcs := []struct {
+		rt  ResourceType
+		id  string
+		exp string
+	}{
+		{rt: ComputeInstance, id: "my-project/europe-west1-b/vm", exp: "europe-west1-b"},
+		{rt: ComputeDisk, id: "europe-west1-b/disk", exp: "europe-west1-b"},
+		{rt: ComputeAttachedDisk, id: "my-project/europe-west1-b/vm/disk", exp: "europe-west1-b"},
+		{rt: ComputeNodeGroup, id: "projects/my-project/zones/europe-west1-c/nodeGroups/group", exp: "europe-west1-c"},
+		{rt: ComputeRegionURLMap, id: "projects/my-project/regions/europe-west1/urlMaps/map", exp: "europe-west1"},
+		{rt: CloudSchedulerJob, id: "projects/my-project/locations/europe-west2/jobs/job", exp: "europe-west2"},
+		{rt: ComputeForwardingRule, id: "rule", exp: "europe-west3"},
+		{rt: ComputeNetwork, id: "network", exp: ""},
+		{rt: ComputeGlobalNetworkEndpointGroup, id: "projects/my-project/global/networkEndpointGroups/neg", exp: ""},
+		{rt: DNSRecordSet, id: "zone/www.example.com./A", exp: ""},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.rt.String(), func(t *testing.T) {
+			assert.Equal(t, tc.exp, resourceLocation(tc.rt, tc.id, "europe-west3"))
+		})
+	}
+}
+
+func TestExistingResources(t *testing.T) {
+	er := newExistingResources(map[string]struct{}{
+		"projects/my-project/global/networks/default":                     struct{}{},
+		"projects/my-project/zones/europe-west1-b/instances/vm":           struct{}{},
+		"projects/my-project/zones/europe-west1-b/disks/data":             struct{}{},
+		"projects/my-project/managedZones/zone/rrsets/www.example.com./A": struct{}{},
+		"projects/other-project/global/networks/shared":                   struct{}{},
+	})
+
+	assert.True(t, er.has("my-project", "default"))
+	assert.True(t, er.has("my-project", "my-project/europe-west1-b/vm"))
+	assert.True(t, er.has("my-project", "europe-west1-b/data"))
+	assert.True(t, er.has("my-project", "zone/www.example.com./A"))
+	assert.False(t, er.has("my-project", "my-project/europe-west1-c/vm"))
+	assert.False(t, er.has("my-project", "europe-west1-b/vm2"))
+	assert.False(t, er.has("my-project", "shared"))
+	assert.True(t, er.has("other-project", "shared"))
+	assert.False(t, er.has("other-project", "default"))
+}
+
+func TestFolderIAMPolicy(t *testing.T) {
+	var (
+		ctx = context.Background()
+		g   = &google{}
+	)
+
+	rs, err := folderIAMPolicy(ctx, g, FolderIAMPolicy.String(), &filter.Filter{})
+	require.NoError(t, err)
+	assert.Len(t, rs, 0)
+
+	g.options.Folder = "42"
+	rs, err = folderIAMPolicy(ctx, g, FolderIAMPolicy.String(), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	assert.Equal(t, "folders/42", rs[0].ID())
+}
+
+func TestBillingAccountIAMPolicy(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		status int
+	)
+
+	g := newTestGoogle(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/billingAccounts/012345-6789AB-CDEF01", r.URL.Path)
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			w.Write([]byte(`{"name": "billingAccounts/012345-6789AB-CDEF01"}`))
+		} else {
+			w.Write([]byte(`{"error": {"code": 403, "errors": [{"reason": "forbidden"}]}}`))
+		}
+	}, func(r *GCPReader, opts ...option.ClientOption) (err error) {
+		r.cloudbilling, err = cloudbilling.NewService(ctx, opts...)
+		return err
+	})
+
+	t.Run("NoBillingAccount", func(t *testing.T) {
+		rs, err := billingAccountIAMPolicy(ctx, g, BillingAccountIAMPolicy.String(), &filter.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, rs, 0)
+	})
+
+	g.options.BillingAccount = "012345-6789AB-CDEF01"
+
+	t.Run("Success", func(t *testing.T) {
+		status = http.StatusOK
+		rs, err := billingAccountIAMPolicy(ctx, g, BillingAccountIAMPolicy.String(), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "012345-6789AB-CDEF01", rs[0].ID())
+	})
+	t.Run("PermissionDenied", func(t *testing.T) {
+		status = http.StatusForbidden
+		_, err := billingAccountIAMPolicy(ctx, g, BillingAccountIAMPolicy.String(), &filter.Filter{})
+		assert.True(t, errors.Is(err, errcode.ErrProviderAPI))
+	})
+}
+
+func TestStorageTransferJob(t *testing.T) {
+	ctx := context.Background()
+
+	g := newTestGoogle(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/transferJobs", r.URL.Path)
+		assert.Equal(t, `{"projectId":"my-project"}`, r.URL.Query().Get("filter"))
+		w.Write([]byte(`{"transferJobs": [
+			{"name": "transferJobs/123", "status": "ENABLED"},
+			{"name": "transferJobs/456", "status": "DELETED"}
+		]}`))
+	}, func(r *GCPReader, opts ...option.ClientOption) (err error) {
+		r.transfer, err = storagetransfer.NewService(ctx, opts...)
+		return err
+	})
+
+	rs, err := storageTransferJob(ctx, g, StorageTransferJob.String(), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	assert.Equal(t, "my-project/123", rs[0].ID())
+}
+
+func TestComputeProject(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		requests int
+		body     string
+	)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/projects/my-project", r.URL.Path)
+		w.Write([]byte(body))
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		body = `{"name": "my-project", "defaultNetworkTier": "PREMIUM", "commonInstanceMetadata": {"items": [{"key": "ssh-keys", "value": "user:ssh-rsa AAAA"}]}}`
+		requests = 0
+		g := newTestGoogle(t, handler, setCompute)
+
+		rs, err := computeProjectMetadata(ctx, g, ComputeProjectMetadata.String(), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "my-project", rs[0].ID())
+
+		rs, err = computeProjectDefaultNetworkTier(ctx, g, ComputeProjectDefaultNetworkTier.String(), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "my-project", rs[0].ID())
+
+		assert.Equal(t, 1, requests, "the project is only requested once")
+	})
+	t.Run("NoMetadata", func(t *testing.T) {
+		body = `{"name": "my-project", "defaultNetworkTier": "PREMIUM"}`
+		g := newTestGoogle(t, handler, setCompute)
+
+		rs, err := computeProjectMetadata(ctx, g, ComputeProjectMetadata.String(), &filter.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, rs, 0)
+	})
+}
+
+func TestComputeRegionPerInstanceConfig(t *testing.T) {
+	ctx := context.Background()
+
+	g := newTestGoogle(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/projects/my-project/regions/europe-west1/instanceGroupManagers":
+			w.Write([]byte(`{"items": [{"name": "stateful"}, {"name": "stateless"}]}`))
+		case "/projects/my-project/regions/europe-west1/instanceGroupManagers/stateful/listPerInstanceConfigs":
+			w.Write([]byte(`{"items": [{"name": "vm-1"}, {"name": "vm-2"}]}`))
+		case "/projects/my-project/regions/europe-west1/instanceGroupManagers/stateless/listPerInstanceConfigs":
+			w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, setCompute)
+
+	rs, err := computeRegionPerInstanceConfig(ctx, g, ComputeRegionPerInstanceConfig.String(), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+	assert.Equal(t, "projects/my-project/regions/europe-west1/instanceGroupManagers/stateful/vm-1", rs[0].ID())
+	assert.Equal(t, "projects/my-project/regions/europe-west1/instanceGroupManagers/stateful/vm-2", rs[1].ID())
+}
+
+func TestComputeForwardingRule(t *testing.T) {
+	ctx := context.Background()
+	gateway := "https://www.googleapis.com/compute/v1/projects/my-project/regions/europe-west1/targetVpnGateways/vpn"
+
+	g := newTestGoogle(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/projects/my-project/regions/europe-west1/forwardingRules" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "nextPageToken,items(name,network,target,IPProtocol,portRange)", r.URL.Query().Get("fields"))
+		w.Write([]byte(`{"items": [
+			{"name": "vpn-esp", "IPProtocol": "ESP", "target": "` + gateway + `"},
+			{"name": "vpn-udp500", "IPProtocol": "UDP", "portRange": "500-500", "target": "` + gateway + `"},
+			{"name": "vpn-udp4500", "IPProtocol": "UDP", "portRange": "4500-4500", "target": "` + gateway + `"}
+		]}`))
+	}, setCompute)
+
+	rs, err := computeForwardingRule(ctx, g, ComputeForwardingRule.String(), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 3)
+
+	for i, e := range []struct{ name, protocol, portRange string }{
+		{"vpn-esp", "ESP", ""},
+		{"vpn-udp500", "UDP", "500-500"},
+		{"vpn-udp4500", "UDP", "4500-4500"},
+	} {
+		assert.Equal(t, e.name, rs[i].ID())
+		assert.Equal(t, gateway, rs[i].Data().Get("target"))
+		assert.Equal(t, e.protocol, rs[i].Data().Get("ip_protocol"))
+		assert.Equal(t, e.portRange, rs[i].Data().Get("port_range"))
+	}
+}
+
+func TestComputeInstanceGroupManager(t *testing.T) {
+	ctx := context.Background()
+	stable := "https://www.googleapis.com/compute/v1/projects/my-project/global/instanceTemplates/app-v1"
+	canary := "https://www.googleapis.com/compute/v1/projects/my-project/global/instanceTemplates/app-v2"
+
+	g := newTestGoogle(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/projects/my-project/zones/europe-west1-b/instanceGroupManagers" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"items": [{
+			"name": "app",
+			"versions": [
+				{"name": "stable", "instanceTemplate": "` + stable + `"},
+				{"name": "canary", "instanceTemplate": "` + canary + `", "targetSize": {"percent": 10}}
+			],
+			"updatePolicy": {"type": "PROACTIVE", "minimalAction": "REPLACE", "maxSurge": {"fixed": 3}, "maxUnavailable": {"fixed": 0}}
+		}]}`))
+	}, setCompute)
+	g.gcpr.zones = []string{"europe-west1-b"}
+
+	rs, err := computeInstanceGroupManager(ctx, g, ComputeInstanceGroupManager.String(), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	assert.Equal(t, "projects/my-project/zones/europe-west1-b/instanceGroupManagers/app", rs[0].ID())
+
+	d := rs[0].Data()
+	assert.Equal(t, 2, d.Get("version.#"))
+	assert.Equal(t, "stable", d.Get("version.0.name"))
+	assert.Equal(t, stable, d.Get("version.0.instance_template"))
+	assert.Equal(t, 0, d.Get("version.0.target_size.#"))
+	assert.Equal(t, "canary", d.Get("version.1.name"))
+	assert.Equal(t, canary, d.Get("version.1.instance_template"))
+	assert.Equal(t, 10, d.Get("version.1.target_size.0.percent"))
+	assert.Equal(t, "PROACTIVE", d.Get("update_policy.0.type"))
+	assert.Equal(t, "REPLACE", d.Get("update_policy.0.minimal_action"))
+	assert.Equal(t, 3, d.Get("update_policy.0.max_surge_fixed"))
+}
+
+func TestTags(t *testing.T) {
+	ctx := context.Background()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/projects/my-project":
+			w.Write([]byte(`{"projectId": "my-project", "projectNumber": "123"}`))
+		case "/v3/tagKeys":
+			assert.Equal(t, "organizations/42", r.URL.Query().Get("parent"))
+			w.Write([]byte(`{"tagKeys": [{"name": "tagKeys/1", "shortName": "env"}]}`))
+		case "/v3/tagValues":
+			assert.Equal(t, "tagKeys/1", r.URL.Query().Get("parent"))
+			w.Write([]byte(`{"tagValues": [{"name": "tagValues/10", "shortName": "prod"}, {"name": "tagValues/11", "shortName": "dev"}]}`))
+		case "/v3/tagBindings":
+			assert.Equal(t, "//cloudresourcemanager.googleapis.com/projects/123", r.URL.Query().Get("parent"))
+			w.Write([]byte(`{"tagBindings": [{
+				"name": "tagBindings/%2F%2Fcloudresourcemanager.googleapis.com%2Fprojects%2F123/tagValues/10",
+				"parent": "//cloudresourcemanager.googleapis.com/projects/123",
+				"tagValue": "tagValues/10"
+			}]}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+	setReader := func(r *GCPReader, opts ...option.ClientOption) (err error) {
+		r.tags, err = cloudresourcemanager.NewService(ctx, opts...)
+		if err != nil {
+			return err
+		}
+		r.projects, err = crmv1.NewService(ctx, opts...)
+		return err
+	}
+
+	t.Run("KeysAndValues", func(t *testing.T) {
+		g := newTestGoogle(t, handler, setReader)
+		g.options.Organization = "42"
+
+		rs, err := tagsTagKey(ctx, g, TagsTagKey.String(), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "tagKeys/1", rs[0].ID())
+
+		rs, err = tagsTagValue(ctx, g, TagsTagValue.String(), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 2)
+		assert.Equal(t, "tagValues/10", rs[0].ID())
+		assert.Equal(t, "tagValues/11", rs[1].ID())
+	})
+	t.Run("NoOrganization", func(t *testing.T) {
+		g := newTestGoogle(t, handler, setReader)
+
+		rs, err := tagsTagKey(ctx, g, TagsTagKey.String(), &filter.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, rs, 0)
+	})
+	t.Run("Bindings", func(t *testing.T) {
+		g := newTestGoogle(t, handler, setReader)
+
+		rs, err := tagsTagBinding(ctx, g, TagsTagBinding.String(), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "tagBindings/%2F%2Fcloudresourcemanager.googleapis.com%2Fprojects%2F123/tagValues/10", rs[0].ID())
+
+		// The parent, needed to read it, is set from the ID
+		d := rs[0].TFResource().Data(nil)
+		d.SetId(rs[0].ID())
+		ds, err := rs[0].TFResource().Importer.State(d, nil)
+		require.NoError(t, err)
+		require.Len(t, ds, 1)
+		assert.Equal(t, "%2F%2Fcloudresourcemanager.googleapis.com%2Fprojects%2F123/tagValues/10", ds[0].Id())
+		assert.Equal(t, "//cloudresourcemanager.googleapis.com/projects/123", ds[0].Get("parent"))
+	})
+}
+
+func TestAccessContextManager(t *testing.T) {
+	ctx := context.Background()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/accessPolicies":
+			assert.Equal(t, "organizations/42", r.URL.Query().Get("parent"))
+			w.Write([]byte(`{"accessPolicies": [{"name": "accessPolicies/1", "title": "default"}]}`))
+		case "/v1/accessPolicies/1/accessLevels":
+			w.Write([]byte(`{"accessLevels": [{"name": "accessPolicies/1/accessLevels/corp_ips", "title": "Corp IPs"}]}`))
+		case "/v1/accessPolicies/1/servicePerimeters":
+			w.Write([]byte(`{"servicePerimeters": [{
+				"name": "accessPolicies/1/servicePerimeters/prod",
+				"title": "Prod",
+				"perimeterType": "PERIMETER_TYPE_REGULAR",
+				"status": {
+					"restrictedServices": ["storage.googleapis.com", "bigquery.googleapis.com"],
+					"resources": ["projects/123"],
+					"accessLevels": ["accessPolicies/1/accessLevels/corp_ips"]
+				}
+			}]}`))
+		case "/v1/accessPolicies/2/accessLevels":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error": {"code": 403, "errors": [{"reason": "forbidden"}]}}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+	setReader := func(r *GCPReader, opts ...option.ClientOption) (err error) {
+		r.accesscontext, err = accesscontextmanager.NewService(ctx, opts...)
+		return err
+	}
+
+	t.Run("AccessLevels", func(t *testing.T) {
+		g := newTestGoogle(t, handler, setReader)
+		g.options.Organization = "42"
+
+		rs, err := accessContextManagerAccessLevel(ctx, g, AccessContextManagerAccessLevel.String(), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "accessPolicies/1/accessLevels/corp_ips", rs[0].ID())
+		assert.Equal(t, "accessPolicies/1", rs[0].Data().Get("parent"))
+		assert.Equal(t, "Corp IPs", rs[0].Data().Get("title"))
+	})
+	t.Run("ServicePerimeters", func(t *testing.T) {
+		g := newTestGoogle(t, handler, setReader)
+		g.options.AccessPolicy = "1"
+
+		rs, err := accessContextManagerServicePerimeter(ctx, g, AccessContextManagerServicePerimeter.String(), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "accessPolicies/1/servicePerimeters/prod", rs[0].ID())
+
+		d := rs[0].Data()
+		assert.Equal(t, "accessPolicies/1", d.Get("parent"))
+		assert.Equal(t, "PERIMETER_TYPE_REGULAR", d.Get("perimeter_type"))
+		assert.ElementsMatch(t, []interface{}{"storage.googleapis.com", "bigquery.googleapis.com"}, d.Get("status.0.restricted_services").(*schema.Set).List())
+		assert.Equal(t, []interface{}{"projects/123"}, d.Get("status.0.resources"))
+		assert.Equal(t, []interface{}{"accessPolicies/1/accessLevels/corp_ips"}, d.Get("status.0.access_levels"))
+	})
+	t.Run("NoAccessPolicy", func(t *testing.T) {
+		g := newTestGoogle(t, handler, setReader)
+
+		rs, err := accessContextManagerAccessLevel(ctx, g, AccessContextManagerAccessLevel.String(), &filter.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, rs, 0)
+	})
+	t.Run("PermissionDenied", func(t *testing.T) {
+		g := newTestGoogle(t, handler, setReader)
+		g.options.AccessPolicy = "2"
+
+		_, err := accessContextManagerAccessLevel(ctx, g, AccessContextManagerAccessLevel.String(), &filter.Filter{})
+		assert.True(t, errors.Is(err, errcode.ErrProviderAPI))
+	})
+}
+
+func TestComputeRegionNetworkEndpointGroup(t *testing.T) {
+	ctx := context.Background()
+
+	g := newTestGoogle(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/projects/my-project/regions/europe-west1/networkEndpointGroups" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "nextPageToken,items(name,network,networkEndpointType,cloudRun,appEngine,cloudFunction)", r.URL.Query().Get("fields"))
+		w.Write([]byte(`{"items": [
+			{"name": "run-neg", "networkEndpointType": "SERVERLESS", "cloudRun": {"service": "run-service", "tag": "blue"}},
+			{"name": "app-neg", "networkEndpointType": "SERVERLESS", "appEngine": {"service": "default", "version": "v1"}},
+			{"name": "function-neg", "networkEndpointType": "SERVERLESS", "cloudFunction": {"function": "my-function"}}
+		]}`))
+	}, setCompute)
+
+	rs, err := computeRegionNetworkEndpointGroup(ctx, g, ComputeRegionNetworkEndpointGroup.String(), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 3)
+
+	run := rs[0].Data()
+	assert.Equal(t, "projects/my-project/regions/europe-west1/networkEndpointGroups/run-neg", rs[0].ID())
+	assert.Equal(t, "SERVERLESS", run.Get("network_endpoint_type"))
+	assert.Equal(t, "run-service", run.Get("cloud_run.0.service"))
+	assert.Equal(t, "blue", run.Get("cloud_run.0.tag"))
+	assert.Equal(t, 0, run.Get("app_engine.#"))
+	assert.Equal(t, 0, run.Get("cloud_function.#"))
+
+	app := rs[1].Data()
+	assert.Equal(t, "default", app.Get("app_engine.0.service"))
+	assert.Equal(t, "v1", app.Get("app_engine.0.version"))
+	assert.Equal(t, 0, app.Get("cloud_run.#"))
+
+	function := rs[2].Data()
+	assert.Equal(t, "my-function", function.Get("cloud_function.0.function"))
+	assert.Equal(t, 0, function.Get("cloud_run.#"))
+}
+
+func TestComposerEnvironment(t *testing.T) {
+	ctx := context.Background()
+
+	g := newTestGoogle(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/projects/my-project/locations/europe-west1/environments" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"environments": [
+			{
+				"name": "projects/my-project/locations/europe-west1/environments/airflow",
+				"labels": {"env": "prod"},
+				"config": {
+					"nodeCount": 3,
+					"softwareConfig": {"imageVersion": "composer-1.17.0-airflow-2.1.2", "pypiPackages": {"pandas": ">=1.3"}},
+					"nodeConfig": {"location": "projects/my-project/zones/europe-west1-b", "machineType": "n1-standard-2", "diskSizeGb": 100}
+				}
+			},
+			{"name": "projects/my-project/locations/europe-west1/environments/sandbox", "labels": {"env": "dev"}}
+		]}`))
+	}, func(r *GCPReader, opts ...option.ClientOption) (err error) {
+		r.composer, err = composer.NewService(ctx, opts...)
+		return err
+	})
+
+	rs, err := composerEnvironment(ctx, g, ComposerEnvironment.String(), &filter.Filter{Tags: []tag.Tag{{Name: "env", Value: "prod"}}})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	assert.Equal(t, "projects/my-project/locations/europe-west1/environments/airflow", rs[0].ID())
+
+	d := rs[0].Data()
+	assert.Equal(t, 3, d.Get("config.0.node_count"))
+	assert.Equal(t, "composer-1.17.0-airflow-2.1.2", d.Get("config.0.software_config.0.image_version"))
+	assert.Equal(t, ">=1.3", d.Get("config.0.software_config.0.pypi_packages.pandas"))
+	assert.Equal(t, "projects/my-project/zones/europe-west1-b", d.Get("config.0.node_config.0.zone"))
+	assert.Equal(t, "n1-standard-2", d.Get("config.0.node_config.0.machine_type"))
+	assert.Equal(t, 100, d.Get("config.0.node_config.0.disk_size_gb"))
+}
+
+func TestBinaryAuthorization(t *testing.T) {
+	ctx := context.Background()
+	setReader := func(r *GCPReader, opts ...option.ClientOption) (err error) {
+		r.binauthz, err = binaryauthorization.NewService(ctx, opts...)
+		return err
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		g := newTestGoogle(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/v1/projects/my-project/policy":
+				w.Write([]byte(`{"name": "projects/my-project/policy", "defaultAdmissionRule": {"evaluationMode": "ALWAYS_ALLOW", "enforcementMode": "ENFORCED_BLOCK_AND_AUDIT_LOG"}}`))
+			case "/v1/projects/my-project/attestors":
+				w.Write([]byte(`{"attestors": [
+					{
+						"name": "projects/my-project/attestors/built-by-ci",
+						"description": "Built by the CI",
+						"userOwnedGrafeasNote": {"noteReference": "projects/security-project/notes/built-by-ci"}
+					}
+				]}`))
+			default:
+				t.Errorf("unexpected request to %s", r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}, setReader)
+
+		rs, err := binaryAuthorizationPolicy(ctx, g, BinaryAuthorizationPolicy.String(), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "my-project", rs[0].ID())
+
+		rs, err = binaryAuthorizationAttestor(ctx, g, BinaryAuthorizationAttestor.String(), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		assert.Equal(t, "projects/my-project/attestors/built-by-ci", rs[0].ID())
+
+		d := rs[0].Data()
+		assert.Equal(t, "built-by-ci", d.Get("name"))
+		assert.Equal(t, "Built by the CI", d.Get("description"))
+		assert.Equal(t, "projects/security-project/notes/built-by-ci", d.Get("attestation_authority_note.0.note_reference"))
+	})
+
+	t.Run("DisabledAPI", func(t *testing.T) {
+		g := newTestGoogle(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error": {"code": 403, "message": "disabled", "errors": [
+				{"reason": "accessNotConfigured", "message": "Binary Authorization API has not been used in project 42 before or it is disabled."}
+			]}}`))
+		}, setReader)
+		g.options.Experimental = true
+
+		for _, rt := range []ResourceType{BinaryAuthorizationPolicy, BinaryAuthorizationAttestor} {
+			_, err := g.Resources(ctx, rt.String(), &filter.Filter{})
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, errcode.ErrProviderAPI))
+			assert.Contains(t, err.Error(), "because the Binary Authorization API is disabled")
+		}
+	})
+}
+
+func TestComputeDisk(t *testing.T) {
+	ctx := context.Background()
+
+	g := newTestGoogle(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/projects/my-project/zones/europe-west1-b/disks" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "nextPageToken,items(name,status,resourcePolicies,sourceImage,sourceSnapshot)", r.URL.Query().Get("fields"))
+		w.Write([]byte(`{"items": [
+			{
+				"name": "from-image",
+				"status": "READY",
+				"sourceImage": "https://www.googleapis.com/compute/v1/projects/debian-cloud/global/images/debian-10-buster-v20210721",
+				"resourcePolicies": ["https://www.googleapis.com/compute/v1/projects/my-project/regions/europe-west1/resourcePolicies/daily"]
+			},
+			{
+				"name": "from-snapshot",
+				"status": "READY",
+				"sourceSnapshot": "https://www.googleapis.com/compute/v1/projects/my-project/global/snapshots/backup"
+			}
+		]}`))
+	}, setCompute)
+	g.gcpr.zones = []string{"europe-west1-b"}
+
+	rs, err := computeDisk(ctx, g, ComputeDisk.String(), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+
+	sort.Slice(rs, func(i, j int) bool { return rs[i].ID() < rs[j].ID() })
+
+	assert.Equal(t, "europe-west1-b/from-image", rs[0].ID())
+	assert.Equal(t, "https://www.googleapis.com/compute/v1/projects/debian-cloud/global/images/debian-10-buster-v20210721", rs[0].Data().Get("image"))
+	assert.Equal(t, "", rs[0].Data().Get("snapshot"))
+
+	assert.Equal(t, "europe-west1-b/from-snapshot", rs[1].ID())
+	assert.Equal(t, "", rs[1].Data().Get("image"))
+	assert.Equal(t, "https://www.googleapis.com/compute/v1/projects/my-project/global/snapshots/backup", rs[1].Data().Get("snapshot"))
+
+	// The resource policies are attached with their own resource
+	rs, err = computeDiskResourcePolicyAttachment(ctx, g, ComputeDiskResourcePolicyAttachment.String(), &filter.Filter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	assert.Equal(t, "my-project/europe-west1-b/from-image/daily", rs[0].ID())
+}
+
+func TestComputeBackendCDN(t *testing.T) {
+	ctx := context.Background()
+
+	g := newTestGoogle(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/projects/my-project/global/backendBuckets":
+			assert.Equal(t, "nextPageToken,items(name,enableCdn,cdnPolicy)", r.URL.Query().Get("fields"))
+			w.Write([]byte(`{"items": [
+				{"name": "static", "enableCdn": true, "cdnPolicy": {"signedUrlCacheMaxAgeSec": "7200"}},
+				{"name": "private"}
+			]}`))
+		case "/projects/my-project/global/backendServices":
+			assert.Equal(t, "nextPageToken,items(name,enableCDN,cdnPolicy)", r.URL.Query().Get("fields"))
+			w.Write([]byte(`{"items": [
+				{
+					"name": "web",
+					"enableCDN": true,
+					"cdnPolicy": {
+						"signedUrlCacheMaxAgeSec": "3600",
+						"cacheKeyPolicy": {"includeHost": true, "includeProtocol": true, "includeQueryString": true, "queryStringWhitelist": ["page", "lang"]}
+					}
+				}
+			]}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, setCompute)
+
+	t.Run("BackendBucket", func(t *testing.T) {
+		rs, err := computeBackendBucket(ctx, g, ComputeBackendBucket.String(), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 2)
+
+		assert.Equal(t, "static", rs[0].ID())
+		assert.Equal(t, true, rs[0].Data().Get("enable_cdn"))
+		assert.Equal(t, 7200, rs[0].Data().Get("cdn_policy.0.signed_url_cache_max_age_sec"))
+
+		assert.Equal(t, "private", rs[1].ID())
+		assert.Equal(t, false, rs[1].Data().Get("enable_cdn"))
+		assert.Empty(t, rs[1].Data().Get("cdn_policy"))
+	})
+
+	t.Run("BackendService", func(t *testing.T) {
+		rs, err := computeBackendService(ctx, g, ComputeBackendService.String(), &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+
+		r := rs[0]
+		require.NoError(t, r.Data().Set("name", "web"))
+
+		mx := mxwriter.NewMux()
+		hw := hcl.NewWriter(mx, g, &writer.Options{})
+		require.NoError(t, r.HCL(hw))
+		require.NoError(t, hw.Sync())
+		b, err := ioutil.ReadAll(mx)
+		require.NoError(t, err)
+
+		// The HCL is parsed back to check the CDN configuration
+		f, diags := hclsyntax.ParseConfig(b, "hcl.tf", hcl2.InitialPos)
+		require.False(t, diags.HasErrors(), diags.Error())
+		blocks := f.Body.(*hclsyntax.Body).Blocks
+		require.Len(t, blocks, 2)
+		body := blocks[1].Body
+
+		v, diags := body.Attributes["enable_cdn"].Expr.Value(nil)
+		require.False(t, diags.HasErrors(), diags.Error())
+		assert.True(t, v.True())
+
+		var cdn, key *hclsyntax.Body
+		for _, b := range body.Blocks {
+			if b.Type == "cdn_policy" {
+				cdn = b.Body
+			}
+		}
+		require.NotNil(t, cdn)
+		for _, b := range cdn.Blocks {
+			if b.Type == "cache_key_policy" {
+				key = b.Body
+			}
+		}
+		require.NotNil(t, key)
+
+		v, diags = cdn.Attributes["signed_url_cache_max_age_sec"].Expr.Value(nil)
+		require.False(t, diags.HasErrors(), diags.Error())
+		assert.Equal(t, "3600", v.AsBigFloat().String())
+
+		for _, k := range []string{"include_host", "include_protocol", "include_query_string"} {
+			v, diags = key.Attributes[k].Expr.Value(nil)
+			require.False(t, diags.HasErrors(), diags.Error())
+			assert.True(t, v.True(), k)
+		}
+
+		v, diags = key.Attributes["query_string_whitelist"].Expr.Value(nil)
+		require.False(t, diags.HasErrors(), diags.Error())
+		whitelist := make([]string, 0)
+		for _, e := range v.AsValueSlice() {
+			whitelist = append(whitelist, e.AsString())
+		}
+		assert.ElementsMatch(t, []string{"page", "lang"}, whitelist)
+	})
+}
+
+// newTestGoogle creates a google on the project 'my-project' and the
+// region 'europe-west1' which GCPReader requests a server with the
+// handler, setReader has to set on it the services used with the opts
+func newTestGoogle(t *testing.T, handler http.HandlerFunc, setReader func(r *GCPReader, opts ...option.ClientOption) error) *google {
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	r := &GCPReader{project: "my-project", region: "europe-west1", maxResults: 500, lists: newListCache()}
+	require.NoError(t, setReader(r, option.WithHTTPClient(ts.Client()), option.WithEndpoint(ts.URL+"/")))
+
+	return &google{
+		tfGoogleClient: &tfgoogle.Config{Project: "my-project", Region: "europe-west1"},
+		tfProvider:     tfgoogle.Provider(),
+		gcpr:           r,
+	}
+}
+
+// setCompute sets the compute service of the GCPReader
+func setCompute(r *GCPReader, opts ...option.ClientOption) (err error) {
+	r.compute, err = compute.NewService(context.Background(), opts...)
+	return err
+}
@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _ResourceTypeName = "google_compute_instancegoogle_compute_firewallgoogle_compute_networkgoogle_compute_health_checkgoogle_compute_instance_groupgoogle_compute_instance_iam_policygoogle_compute_backend_bucketgoogle_compute_backend_servicegoogle_compute_ssl_certificategoogle_compute_target_http_proxygoogle_compute_target_https_proxygoogle_compute_url_mapgoogle_compute_global_forwarding_rulegoogle_compute_forwarding_rulegoogle_compute_diskgoogle_dns_managed_zonegoogle_dns_record_setgoogle_project_iam_custom_rolegoogle_storage_bucketgoogle_storage_bucket_iam_policygoogle_sql_database_instance"
+const _ResourceTypeName = "google_compute_instancegoogle_compute_firewallgoogle_compute_networkgoogle_compute_network_peeringgoogle_compute_health_checkgoogle_compute_region_health_checkgoogle_compute_http_health_checkgoogle_compute_https_health_checkgoogle_compute_instance_groupgoogle_compute_instance_group_named_portgoogle_compute_instance_iam_policygoogle_compute_instance_group_managergoogle_compute_region_instance_group_managergoogle_compute_per_instance_configgoogle_compute_region_per_instance_configgoogle_compute_backend_bucketgoogle_compute_backend_servicegoogle_compute_ssl_certificategoogle_compute_target_http_proxygoogle_compute_target_https_proxygoogle_compute_url_mapgoogle_compute_region_ssl_certificategoogle_compute_region_target_http_proxygoogle_compute_region_target_https_proxygoogle_compute_region_url_mapgoogle_compute_global_forwarding_rulegoogle_compute_forwarding_rulegoogle_compute_diskgoogle_compute_resource_policygoogle_compute_disk_resource_policy_attachmentgoogle_compute_attached_diskgoogle_compute_node_templategoogle_compute_node_groupgoogle_compute_global_network_endpoint_groupgoogle_compute_region_network_endpoint_groupgoogle_compute_interconnect_attachmentgoogle_compute_external_vpn_gatewaygoogle_compute_reservationgoogle_compute_ssl_policygoogle_compute_target_ssl_proxygoogle_compute_target_tcp_proxygoogle_compute_target_instancegoogle_compute_target_grpc_proxygoogle_compute_project_metadatagoogle_compute_project_default_network_tiergoogle_cloud_scheduler_jobgoogle_cloud_tasks_queuegoogle_workflows_workflowgoogle_eventarc_triggergoogle_composer_environmentgoogle_os_config_patch_deploymentgoogle_filestore_instancegoogle_pubsub_topic_iam_policygoogle_logging_project_sinkgoogle_logging_metricgoogle_monitoring_alert_policygoogle_monitoring_notification_channelgoogle_dataproc_clustergoogle_iap_brandgoogle_iap_web_iam_policygoogle_iap_web_backend_service_iam_policygoogle_cloud_identity_groupgoogle_cloud_identity_group_membershipgoogle_app_engine_applicationgoogle_app_engine_standard_app_versiongoogle_app_engine_service_split_trafficgoogle_bigtable_instancegoogle_bigtable_tablegoogle_dns_managed_zonegoogle_dns_record_setgoogle_project_iam_custom_rolegoogle_organization_iam_custom_rolegoogle_folder_iam_policygoogle_billing_account_iam_policygoogle_tags_tag_keygoogle_tags_tag_valuegoogle_tags_tag_bindinggoogle_access_context_manager_access_levelgoogle_access_context_manager_service_perimetergoogle_binary_authorization_policygoogle_binary_authorization_attestorgoogle_storage_bucketgoogle_storage_bucket_iam_policygoogle_storage_transfer_jobgoogle_sql_database_instance"
 
-var _ResourceTypeIndex = [...]uint16{0, 23, 46, 68, 95, 124, 158, 187, 217, 247, 279, 312, 334, 371, 401, 420, 443, 464, 494, 515, 547, 575}
+var _ResourceTypeIndex = [...]uint16{0, 23, 46, 68, 98, 125, 159, 191, 224, 253, 293, 327, 364, 408, 442, 483, 512, 542, 572, 604, 637, 659, 696, 735, 775, 804, 841, 871, 890, 920, 966, 994, 1022, 1047, 1091, 1135, 1173, 1208, 1234, 1259, 1290, 1321, 1351, 1383, 1414, 1457, 1483, 1507, 1532, 1555, 1582, 1615, 1640, 1670, 1697, 1718, 1748, 1786, 1809, 1825, 1850, 1891, 1918, 1956, 1985, 2023, 2062, 2086, 2107, 2130, 2151, 2181, 2216, 2240, 2273, 2292, 2313, 2336, 2378, 2425, 2459, 2495, 2516, 2548, 2575, 2603}
 
-const _ResourceTypeLowerName = "google_compute_instancegoogle_compute_firewallgoogle_compute_networkgoogle_compute_health_checkgoogle_compute_instance_groupgoogle_compute_instance_iam_policygoogle_compute_backend_bucketgoogle_compute_backend_servicegoogle_compute_ssl_certificategoogle_compute_target_http_proxygoogle_compute_target_https_proxygoogle_compute_url_mapgoogle_compute_global_forwarding_rulegoogle_compute_forwarding_rulegoogle_compute_diskgoogle_dns_managed_zonegoogle_dns_record_setgoogle_project_iam_custom_rolegoogle_storage_bucketgoogle_storage_bucket_iam_policygoogle_sql_database_instance"
+const _ResourceTypeLowerName = "google_compute_instancegoogle_compute_firewallgoogle_compute_networkgoogle_compute_network_peeringgoogle_compute_health_checkgoogle_compute_region_health_checkgoogle_compute_http_health_checkgoogle_compute_https_health_checkgoogle_compute_instance_groupgoogle_compute_instance_group_named_portgoogle_compute_instance_iam_policygoogle_compute_instance_group_managergoogle_compute_region_instance_group_managergoogle_compute_per_instance_configgoogle_compute_region_per_instance_configgoogle_compute_backend_bucketgoogle_compute_backend_servicegoogle_compute_ssl_certificategoogle_compute_target_http_proxygoogle_compute_target_https_proxygoogle_compute_url_mapgoogle_compute_region_ssl_certificategoogle_compute_region_target_http_proxygoogle_compute_region_target_https_proxygoogle_compute_region_url_mapgoogle_compute_global_forwarding_rulegoogle_compute_forwarding_rulegoogle_compute_diskgoogle_compute_resource_policygoogle_compute_disk_resource_policy_attachmentgoogle_compute_attached_diskgoogle_compute_node_templategoogle_compute_node_groupgoogle_compute_global_network_endpoint_groupgoogle_compute_region_network_endpoint_groupgoogle_compute_interconnect_attachmentgoogle_compute_external_vpn_gatewaygoogle_compute_reservationgoogle_compute_ssl_policygoogle_compute_target_ssl_proxygoogle_compute_target_tcp_proxygoogle_compute_target_instancegoogle_compute_target_grpc_proxygoogle_compute_project_metadatagoogle_compute_project_default_network_tiergoogle_cloud_scheduler_jobgoogle_cloud_tasks_queuegoogle_workflows_workflowgoogle_eventarc_triggergoogle_composer_environmentgoogle_os_config_patch_deploymentgoogle_filestore_instancegoogle_pubsub_topic_iam_policygoogle_logging_project_sinkgoogle_logging_metricgoogle_monitoring_alert_policygoogle_monitoring_notification_channelgoogle_dataproc_clustergoogle_iap_brandgoogle_iap_web_iam_policygoogle_iap_web_backend_service_iam_policygoogle_cloud_identity_groupgoogle_cloud_identity_group_membershipgoogle_app_engine_applicationgoogle_app_engine_standard_app_versiongoogle_app_engine_service_split_trafficgoogle_bigtable_instancegoogle_bigtable_tablegoogle_dns_managed_zonegoogle_dns_record_setgoogle_project_iam_custom_rolegoogle_organization_iam_custom_rolegoogle_folder_iam_policygoogle_billing_account_iam_policygoogle_tags_tag_keygoogle_tags_tag_valuegoogle_tags_tag_bindinggoogle_access_context_manager_access_levelgoogle_access_context_manager_service_perimetergoogle_binary_authorization_policygoogle_binary_authorization_attestorgoogle_storage_bucketgoogle_storage_bucket_iam_policygoogle_storage_transfer_jobgoogle_sql_database_instance"
 
 func (i ResourceType) String() string {
 	if i < 0 || i >= ResourceType(len(_ResourceTypeIndex)-1) {
@@ -27,95 +27,351 @@ func _ResourceTypeNoOp() {
 	_ = x[ComputeInstance-(0)]
 	_ = x[ComputeFirewall-(1)]
 	_ = x[ComputeNetwork-(2)]
-	_ = x[ComputeHealthCheck-(3)]
-	_ = x[ComputeInstanceGroup-(4)]
-	_ = x[ComputeInstanceIAMPolicy-(5)]
-	_ = x[ComputeBackendBucket-(6)]
-	_ = x[ComputeBackendService-(7)]
-	_ = x[ComputeSSLCertificate-(8)]
-	_ = x[ComputeTargetHTTPProxy-(9)]
-	_ = x[ComputeTargetHTTPSProxy-(10)]
-	_ = x[ComputeURLMap-(11)]
-	_ = x[ComputeGlobalForwardingRule-(12)]
-	_ = x[ComputeForwardingRule-(13)]
-	_ = x[ComputeDisk-(14)]
-	_ = x[DNSManagedZone-(15)]
-	_ = x[DNSRecordSet-(16)]
-	_ = x[ProjectIAMCustomRole-(17)]
-	_ = x[StorageBucket-(18)]
-	_ = x[StorageBucketIAMPolicy-(19)]
-	_ = x[SQLDatabaseInstance-(20)]
+	_ = x[ComputeNetworkPeering-(3)]
+	_ = x[ComputeHealthCheck-(4)]
+	_ = x[ComputeRegionHealthCheck-(5)]
+	_ = x[ComputeHTTPHealthCheck-(6)]
+	_ = x[ComputeHTTPSHealthCheck-(7)]
+	_ = x[ComputeInstanceGroup-(8)]
+	_ = x[ComputeInstanceGroupNamedPort-(9)]
+	_ = x[ComputeInstanceIAMPolicy-(10)]
+	_ = x[ComputeInstanceGroupManager-(11)]
+	_ = x[ComputeRegionInstanceGroupManager-(12)]
+	_ = x[ComputePerInstanceConfig-(13)]
+	_ = x[ComputeRegionPerInstanceConfig-(14)]
+	_ = x[ComputeBackendBucket-(15)]
+	_ = x[ComputeBackendService-(16)]
+	_ = x[ComputeSSLCertificate-(17)]
+	_ = x[ComputeTargetHTTPProxy-(18)]
+	_ = x[ComputeTargetHTTPSProxy-(19)]
+	_ = x[ComputeURLMap-(20)]
+	_ = x[ComputeRegionSSLCertificate-(21)]
+	_ = x[ComputeRegionTargetHTTPProxy-(22)]
+	_ = x[ComputeRegionTargetHTTPSProxy-(23)]
+	_ = x[ComputeRegionURLMap-(24)]
+	_ = x[ComputeGlobalForwardingRule-(25)]
+	_ = x[ComputeForwardingRule-(26)]
+	_ = x[ComputeDisk-(27)]
+	_ = x[ComputeResourcePolicy-(28)]
+	_ = x[ComputeDiskResourcePolicyAttachment-(29)]
+	_ = x[ComputeAttachedDisk-(30)]
+	_ = x[ComputeNodeTemplate-(31)]
+	_ = x[ComputeNodeGroup-(32)]
+	_ = x[ComputeGlobalNetworkEndpointGroup-(33)]
+	_ = x[ComputeRegionNetworkEndpointGroup-(34)]
+	_ = x[ComputeInterconnectAttachment-(35)]
+	_ = x[ComputeExternalVPNGateway-(36)]
+	_ = x[ComputeReservation-(37)]
+	_ = x[ComputeSSLPolicy-(38)]
+	_ = x[ComputeTargetSSLProxy-(39)]
+	_ = x[ComputeTargetTCPProxy-(40)]
+	_ = x[ComputeTargetInstance-(41)]
+	_ = x[ComputeTargetGRPCProxy-(42)]
+	_ = x[ComputeProjectMetadata-(43)]
+	_ = x[ComputeProjectDefaultNetworkTier-(44)]
+	_ = x[CloudSchedulerJob-(45)]
+	_ = x[CloudTasksQueue-(46)]
+	_ = x[WorkflowsWorkflow-(47)]
+	_ = x[EventarcTrigger-(48)]
+	_ = x[ComposerEnvironment-(49)]
+	_ = x[OSConfigPatchDeployment-(50)]
+	_ = x[FilestoreInstance-(51)]
+	_ = x[PubsubTopicIAMPolicy-(52)]
+	_ = x[LoggingProjectSink-(53)]
+	_ = x[LoggingMetric-(54)]
+	_ = x[MonitoringAlertPolicy-(55)]
+	_ = x[MonitoringNotificationChannel-(56)]
+	_ = x[DataprocCluster-(57)]
+	_ = x[IAPBrand-(58)]
+	_ = x[IAPWebIAMPolicy-(59)]
+	_ = x[IAPWebBackendServiceIAMPolicy-(60)]
+	_ = x[CloudIdentityGroup-(61)]
+	_ = x[CloudIdentityGroupMembership-(62)]
+	_ = x[AppEngineApplication-(63)]
+	_ = x[AppEngineStandardAppVersion-(64)]
+	_ = x[AppEngineServiceSplitTraffic-(65)]
+	_ = x[BigtableInstance-(66)]
+	_ = x[BigtableTable-(67)]
+	_ = x[DNSManagedZone-(68)]
+	_ = x[DNSRecordSet-(69)]
+	_ = x[ProjectIAMCustomRole-(70)]
+	_ = x[OrganizationIAMCustomRole-(71)]
+	_ = x[FolderIAMPolicy-(72)]
+	_ = x[BillingAccountIAMPolicy-(73)]
+	_ = x[TagsTagKey-(74)]
+	_ = x[TagsTagValue-(75)]
+	_ = x[TagsTagBinding-(76)]
+	_ = x[AccessContextManagerAccessLevel-(77)]
+	_ = x[AccessContextManagerServicePerimeter-(78)]
+	_ = x[BinaryAuthorizationPolicy-(79)]
+	_ = x[BinaryAuthorizationAttestor-(80)]
+	_ = x[StorageBucket-(81)]
+	_ = x[StorageBucketIAMPolicy-(82)]
+	_ = x[StorageTransferJob-(83)]
+	_ = x[SQLDatabaseInstance-(84)]
 }
 
-var _ResourceTypeValues = []ResourceType{ComputeInstance, ComputeFirewall, ComputeNetwork, ComputeHealthCheck, ComputeInstanceGroup, ComputeInstanceIAMPolicy, ComputeBackendBucket, ComputeBackendService, ComputeSSLCertificate, ComputeTargetHTTPProxy, ComputeTargetHTTPSProxy, ComputeURLMap, ComputeGlobalForwardingRule, ComputeForwardingRule, ComputeDisk, DNSManagedZone, DNSRecordSet, ProjectIAMCustomRole, StorageBucket, StorageBucketIAMPolicy, SQLDatabaseInstance}
+var _ResourceTypeValues = []ResourceType{ComputeInstance, ComputeFirewall, ComputeNetwork, ComputeNetworkPeering, ComputeHealthCheck, ComputeRegionHealthCheck, ComputeHTTPHealthCheck, ComputeHTTPSHealthCheck, ComputeInstanceGroup, ComputeInstanceGroupNamedPort, ComputeInstanceIAMPolicy, ComputeInstanceGroupManager, ComputeRegionInstanceGroupManager, ComputePerInstanceConfig, ComputeRegionPerInstanceConfig, ComputeBackendBucket, ComputeBackendService, ComputeSSLCertificate, ComputeTargetHTTPProxy, ComputeTargetHTTPSProxy, ComputeURLMap, ComputeRegionSSLCertificate, ComputeRegionTargetHTTPProxy, ComputeRegionTargetHTTPSProxy, ComputeRegionURLMap, ComputeGlobalForwardingRule, ComputeForwardingRule, ComputeDisk, ComputeResourcePolicy, ComputeDiskResourcePolicyAttachment, ComputeAttachedDisk, ComputeNodeTemplate, ComputeNodeGroup, ComputeGlobalNetworkEndpointGroup, ComputeRegionNetworkEndpointGroup, ComputeInterconnectAttachment, ComputeExternalVPNGateway, ComputeReservation, ComputeSSLPolicy, ComputeTargetSSLProxy, ComputeTargetTCPProxy, ComputeTargetInstance, ComputeTargetGRPCProxy, ComputeProjectMetadata, ComputeProjectDefaultNetworkTier, CloudSchedulerJob, CloudTasksQueue, WorkflowsWorkflow, EventarcTrigger, ComposerEnvironment, OSConfigPatchDeployment, FilestoreInstance, PubsubTopicIAMPolicy, LoggingProjectSink, LoggingMetric, MonitoringAlertPolicy, MonitoringNotificationChannel, DataprocCluster, IAPBrand, IAPWebIAMPolicy, IAPWebBackendServiceIAMPolicy, CloudIdentityGroup, CloudIdentityGroupMembership, AppEngineApplication, AppEngineStandardAppVersion, AppEngineServiceSplitTraffic, BigtableInstance, BigtableTable, DNSManagedZone, DNSRecordSet, ProjectIAMCustomRole, OrganizationIAMCustomRole, FolderIAMPolicy, BillingAccountIAMPolicy, TagsTagKey, TagsTagValue, TagsTagBinding, AccessContextManagerAccessLevel, AccessContextManagerServicePerimeter, BinaryAuthorizationPolicy, BinaryAuthorizationAttestor, StorageBucket, StorageBucketIAMPolicy, StorageTransferJob, SQLDatabaseInstance}
 
 var _ResourceTypeNameToValueMap = map[string]ResourceType{
-	_ResourceTypeName[0:23]:         ComputeInstance,
-	_ResourceTypeLowerName[0:23]:    ComputeInstance,
-	_ResourceTypeName[23:46]:        ComputeFirewall,
-	_ResourceTypeLowerName[23:46]:   ComputeFirewall,
-	_ResourceTypeName[46:68]:        ComputeNetwork,
-	_ResourceTypeLowerName[46:68]:   ComputeNetwork,
-	_ResourceTypeName[68:95]:        ComputeHealthCheck,
-	_ResourceTypeLowerName[68:95]:   ComputeHealthCheck,
-	_ResourceTypeName[95:124]:       ComputeInstanceGroup,
-	_ResourceTypeLowerName[95:124]:  ComputeInstanceGroup,
-	_ResourceTypeName[124:158]:      ComputeInstanceIAMPolicy,
-	_ResourceTypeLowerName[124:158]: ComputeInstanceIAMPolicy,
-	_ResourceTypeName[158:187]:      ComputeBackendBucket,
-	_ResourceTypeLowerName[158:187]: ComputeBackendBucket,
-	_ResourceTypeName[187:217]:      ComputeBackendService,
-	_ResourceTypeLowerName[187:217]: ComputeBackendService,
-	_ResourceTypeName[217:247]:      ComputeSSLCertificate,
-	_ResourceTypeLowerName[217:247]: ComputeSSLCertificate,
-	_ResourceTypeName[247:279]:      ComputeTargetHTTPProxy,
-	_ResourceTypeLowerName[247:279]: ComputeTargetHTTPProxy,
-	_ResourceTypeName[279:312]:      ComputeTargetHTTPSProxy,
-	_ResourceTypeLowerName[279:312]: ComputeTargetHTTPSProxy,
-	_ResourceTypeName[312:334]:      ComputeURLMap,
-	_ResourceTypeLowerName[312:334]: ComputeURLMap,
-	_ResourceTypeName[334:371]:      ComputeGlobalForwardingRule,
-	_ResourceTypeLowerName[334:371]: ComputeGlobalForwardingRule,
-	_ResourceTypeName[371:401]:      ComputeForwardingRule,
-	_ResourceTypeLowerName[371:401]: ComputeForwardingRule,
-	_ResourceTypeName[401:420]:      ComputeDisk,
-	_ResourceTypeLowerName[401:420]: ComputeDisk,
-	_ResourceTypeName[420:443]:      DNSManagedZone,
-	_ResourceTypeLowerName[420:443]: DNSManagedZone,
-	_ResourceTypeName[443:464]:      DNSRecordSet,
-	_ResourceTypeLowerName[443:464]: DNSRecordSet,
-	_ResourceTypeName[464:494]:      ProjectIAMCustomRole,
-	_ResourceTypeLowerName[464:494]: ProjectIAMCustomRole,
-	_ResourceTypeName[494:515]:      StorageBucket,
-	_ResourceTypeLowerName[494:515]: StorageBucket,
-	_ResourceTypeName[515:547]:      StorageBucketIAMPolicy,
-	_ResourceTypeLowerName[515:547]: StorageBucketIAMPolicy,
-	_ResourceTypeName[547:575]:      SQLDatabaseInstance,
-	_ResourceTypeLowerName[547:575]: SQLDatabaseInstance,
+	_ResourceTypeName[0:23]:           ComputeInstance,
+	_ResourceTypeLowerName[0:23]:      ComputeInstance,
+	_ResourceTypeName[23:46]:          ComputeFirewall,
+	_ResourceTypeLowerName[23:46]:     ComputeFirewall,
+	_ResourceTypeName[46:68]:          ComputeNetwork,
+	_ResourceTypeLowerName[46:68]:     ComputeNetwork,
+	_ResourceTypeName[68:98]:          ComputeNetworkPeering,
+	_ResourceTypeLowerName[68:98]:     ComputeNetworkPeering,
+	_ResourceTypeName[98:125]:         ComputeHealthCheck,
+	_ResourceTypeLowerName[98:125]:    ComputeHealthCheck,
+	_ResourceTypeName[125:159]:        ComputeRegionHealthCheck,
+	_ResourceTypeLowerName[125:159]:   ComputeRegionHealthCheck,
+	_ResourceTypeName[159:191]:        ComputeHTTPHealthCheck,
+	_ResourceTypeLowerName[159:191]:   ComputeHTTPHealthCheck,
+	_ResourceTypeName[191:224]:        ComputeHTTPSHealthCheck,
+	_ResourceTypeLowerName[191:224]:   ComputeHTTPSHealthCheck,
+	_ResourceTypeName[224:253]:        ComputeInstanceGroup,
+	_ResourceTypeLowerName[224:253]:   ComputeInstanceGroup,
+	_ResourceTypeName[253:293]:        ComputeInstanceGroupNamedPort,
+	_ResourceTypeLowerName[253:293]:   ComputeInstanceGroupNamedPort,
+	_ResourceTypeName[293:327]:        ComputeInstanceIAMPolicy,
+	_ResourceTypeLowerName[293:327]:   ComputeInstanceIAMPolicy,
+	_ResourceTypeName[327:364]:        ComputeInstanceGroupManager,
+	_ResourceTypeLowerName[327:364]:   ComputeInstanceGroupManager,
+	_ResourceTypeName[364:408]:        ComputeRegionInstanceGroupManager,
+	_ResourceTypeLowerName[364:408]:   ComputeRegionInstanceGroupManager,
+	_ResourceTypeName[408:442]:        ComputePerInstanceConfig,
+	_ResourceTypeLowerName[408:442]:   ComputePerInstanceConfig,
+	_ResourceTypeName[442:483]:        ComputeRegionPerInstanceConfig,
+	_ResourceTypeLowerName[442:483]:   ComputeRegionPerInstanceConfig,
+	_ResourceTypeName[483:512]:        ComputeBackendBucket,
+	_ResourceTypeLowerName[483:512]:   ComputeBackendBucket,
+	_ResourceTypeName[512:542]:        ComputeBackendService,
+	_ResourceTypeLowerName[512:542]:   ComputeBackendService,
+	_ResourceTypeName[542:572]:        ComputeSSLCertificate,
+	_ResourceTypeLowerName[542:572]:   ComputeSSLCertificate,
+	_ResourceTypeName[572:604]:        ComputeTargetHTTPProxy,
+	_ResourceTypeLowerName[572:604]:   ComputeTargetHTTPProxy,
+	_ResourceTypeName[604:637]:        ComputeTargetHTTPSProxy,
+	_ResourceTypeLowerName[604:637]:   ComputeTargetHTTPSProxy,
+	_ResourceTypeName[637:659]:        ComputeURLMap,
+	_ResourceTypeLowerName[637:659]:   ComputeURLMap,
+	_ResourceTypeName[659:696]:        ComputeRegionSSLCertificate,
+	_ResourceTypeLowerName[659:696]:   ComputeRegionSSLCertificate,
+	_ResourceTypeName[696:735]:        ComputeRegionTargetHTTPProxy,
+	_ResourceTypeLowerName[696:735]:   ComputeRegionTargetHTTPProxy,
+	_ResourceTypeName[735:775]:        ComputeRegionTargetHTTPSProxy,
+	_ResourceTypeLowerName[735:775]:   ComputeRegionTargetHTTPSProxy,
+	_ResourceTypeName[775:804]:        ComputeRegionURLMap,
+	_ResourceTypeLowerName[775:804]:   ComputeRegionURLMap,
+	_ResourceTypeName[804:841]:        ComputeGlobalForwardingRule,
+	_ResourceTypeLowerName[804:841]:   ComputeGlobalForwardingRule,
+	_ResourceTypeName[841:871]:        ComputeForwardingRule,
+	_ResourceTypeLowerName[841:871]:   ComputeForwardingRule,
+	_ResourceTypeName[871:890]:        ComputeDisk,
+	_ResourceTypeLowerName[871:890]:   ComputeDisk,
+	_ResourceTypeName[890:920]:        ComputeResourcePolicy,
+	_ResourceTypeLowerName[890:920]:   ComputeResourcePolicy,
+	_ResourceTypeName[920:966]:        ComputeDiskResourcePolicyAttachment,
+	_ResourceTypeLowerName[920:966]:   ComputeDiskResourcePolicyAttachment,
+	_ResourceTypeName[966:994]:        ComputeAttachedDisk,
+	_ResourceTypeLowerName[966:994]:   ComputeAttachedDisk,
+	_ResourceTypeName[994:1022]:       ComputeNodeTemplate,
+	_ResourceTypeLowerName[994:1022]:  ComputeNodeTemplate,
+	_ResourceTypeName[1022:1047]:      ComputeNodeGroup,
+	_ResourceTypeLowerName[1022:1047]: ComputeNodeGroup,
+	_ResourceTypeName[1047:1091]:      ComputeGlobalNetworkEndpointGroup,
+	_ResourceTypeLowerName[1047:1091]: ComputeGlobalNetworkEndpointGroup,
+	_ResourceTypeName[1091:1135]:      ComputeRegionNetworkEndpointGroup,
+	_ResourceTypeLowerName[1091:1135]: ComputeRegionNetworkEndpointGroup,
+	_ResourceTypeName[1135:1173]:      ComputeInterconnectAttachment,
+	_ResourceTypeLowerName[1135:1173]: ComputeInterconnectAttachment,
+	_ResourceTypeName[1173:1208]:      ComputeExternalVPNGateway,
+	_ResourceTypeLowerName[1173:1208]: ComputeExternalVPNGateway,
+	_ResourceTypeName[1208:1234]:      ComputeReservation,
+	_ResourceTypeLowerName[1208:1234]: ComputeReservation,
+	_ResourceTypeName[1234:1259]:      ComputeSSLPolicy,
+	_ResourceTypeLowerName[1234:1259]: ComputeSSLPolicy,
+	_ResourceTypeName[1259:1290]:      ComputeTargetSSLProxy,
+	_ResourceTypeLowerName[1259:1290]: ComputeTargetSSLProxy,
+	_ResourceTypeName[1290:1321]:      ComputeTargetTCPProxy,
+	_ResourceTypeLowerName[1290:1321]: ComputeTargetTCPProxy,
+	_ResourceTypeName[1321:1351]:      ComputeTargetInstance,
+	_ResourceTypeLowerName[1321:1351]: ComputeTargetInstance,
+	_ResourceTypeName[1351:1383]:      ComputeTargetGRPCProxy,
+	_ResourceTypeLowerName[1351:1383]: ComputeTargetGRPCProxy,
+	_ResourceTypeName[1383:1414]:      ComputeProjectMetadata,
+	_ResourceTypeLowerName[1383:1414]: ComputeProjectMetadata,
+	_ResourceTypeName[1414:1457]:      ComputeProjectDefaultNetworkTier,
+	_ResourceTypeLowerName[1414:1457]: ComputeProjectDefaultNetworkTier,
+	_ResourceTypeName[1457:1483]:      CloudSchedulerJob,
+	_ResourceTypeLowerName[1457:1483]: CloudSchedulerJob,
+	_ResourceTypeName[1483:1507]:      CloudTasksQueue,
+	_ResourceTypeLowerName[1483:1507]: CloudTasksQueue,
+	_ResourceTypeName[1507:1532]:      WorkflowsWorkflow,
+	_ResourceTypeLowerName[1507:1532]: WorkflowsWorkflow,
+	_ResourceTypeName[1532:1555]:      EventarcTrigger,
+	_ResourceTypeLowerName[1532:1555]: EventarcTrigger,
+	_ResourceTypeName[1555:1582]:      ComposerEnvironment,
+	_ResourceTypeLowerName[1555:1582]: ComposerEnvironment,
+	_ResourceTypeName[1582:1615]:      OSConfigPatchDeployment,
+	_ResourceTypeLowerName[1582:1615]: OSConfigPatchDeployment,
+	_ResourceTypeName[1615:1640]:      FilestoreInstance,
+	_ResourceTypeLowerName[1615:1640]: FilestoreInstance,
+	_ResourceTypeName[1640:1670]:      PubsubTopicIAMPolicy,
+	_ResourceTypeLowerName[1640:1670]: PubsubTopicIAMPolicy,
+	_ResourceTypeName[1670:1697]:      LoggingProjectSink,
+	_ResourceTypeLowerName[1670:1697]: LoggingProjectSink,
+	_ResourceTypeName[1697:1718]:      LoggingMetric,
+	_ResourceTypeLowerName[1697:1718]: LoggingMetric,
+	_ResourceTypeName[1718:1748]:      MonitoringAlertPolicy,
+	_ResourceTypeLowerName[1718:1748]: MonitoringAlertPolicy,
+	_ResourceTypeName[1748:1786]:      MonitoringNotificationChannel,
+	_ResourceTypeLowerName[1748:1786]: MonitoringNotificationChannel,
+	_ResourceTypeName[1786:1809]:      DataprocCluster,
+	_ResourceTypeLowerName[1786:1809]: DataprocCluster,
+	_ResourceTypeName[1809:1825]:      IAPBrand,
+	_ResourceTypeLowerName[1809:1825]: IAPBrand,
+	_ResourceTypeName[1825:1850]:      IAPWebIAMPolicy,
+	_ResourceTypeLowerName[1825:1850]: IAPWebIAMPolicy,
+	_ResourceTypeName[1850:1891]:      IAPWebBackendServiceIAMPolicy,
+	_ResourceTypeLowerName[1850:1891]: IAPWebBackendServiceIAMPolicy,
+	_ResourceTypeName[1891:1918]:      CloudIdentityGroup,
+	_ResourceTypeLowerName[1891:1918]: CloudIdentityGroup,
+	_ResourceTypeName[1918:1956]:      CloudIdentityGroupMembership,
+	_ResourceTypeLowerName[1918:1956]: CloudIdentityGroupMembership,
+	_ResourceTypeName[1956:1985]:      AppEngineApplication,
+	_ResourceTypeLowerName[1956:1985]: AppEngineApplication,
+	_ResourceTypeName[1985:2023]:      AppEngineStandardAppVersion,
+	_ResourceTypeLowerName[1985:2023]: AppEngineStandardAppVersion,
+	_ResourceTypeName[2023:2062]:      AppEngineServiceSplitTraffic,
+	_ResourceTypeLowerName[2023:2062]: AppEngineServiceSplitTraffic,
+	_ResourceTypeName[2062:2086]:      BigtableInstance,
+	_ResourceTypeLowerName[2062:2086]: BigtableInstance,
+	_ResourceTypeName[2086:2107]:      BigtableTable,
+	_ResourceTypeLowerName[2086:2107]: BigtableTable,
+	_ResourceTypeName[2107:2130]:      DNSManagedZone,
+	_ResourceTypeLowerName[2107:2130]: DNSManagedZone,
+	_ResourceTypeName[2130:2151]:      DNSRecordSet,
+	_ResourceTypeLowerName[2130:2151]: DNSRecordSet,
+	_ResourceTypeName[2151:2181]:      ProjectIAMCustomRole,
+	_ResourceTypeLowerName[2151:2181]: ProjectIAMCustomRole,
+	_ResourceTypeName[2181:2216]:      OrganizationIAMCustomRole,
+	_ResourceTypeLowerName[2181:2216]: OrganizationIAMCustomRole,
+	_ResourceTypeName[2216:2240]:      FolderIAMPolicy,
+	_ResourceTypeLowerName[2216:2240]: FolderIAMPolicy,
+	_ResourceTypeName[2240:2273]:      BillingAccountIAMPolicy,
+	_ResourceTypeLowerName[2240:2273]: BillingAccountIAMPolicy,
+	_ResourceTypeName[2273:2292]:      TagsTagKey,
+	_ResourceTypeLowerName[2273:2292]: TagsTagKey,
+	_ResourceTypeName[2292:2313]:      TagsTagValue,
+	_ResourceTypeLowerName[2292:2313]: TagsTagValue,
+	_ResourceTypeName[2313:2336]:      TagsTagBinding,
+	_ResourceTypeLowerName[2313:2336]: TagsTagBinding,
+	_ResourceTypeName[2336:2378]:      AccessContextManagerAccessLevel,
+	_ResourceTypeLowerName[2336:2378]: AccessContextManagerAccessLevel,
+	_ResourceTypeName[2378:2425]:      AccessContextManagerServicePerimeter,
+	_ResourceTypeLowerName[2378:2425]: AccessContextManagerServicePerimeter,
+	_ResourceTypeName[2425:2459]:      BinaryAuthorizationPolicy,
+	_ResourceTypeLowerName[2425:2459]: BinaryAuthorizationPolicy,
+	_ResourceTypeName[2459:2495]:      BinaryAuthorizationAttestor,
+	_ResourceTypeLowerName[2459:2495]: BinaryAuthorizationAttestor,
+	_ResourceTypeName[2495:2516]:      StorageBucket,
+	_ResourceTypeLowerName[2495:2516]: StorageBucket,
+	_ResourceTypeName[2516:2548]:      StorageBucketIAMPolicy,
+	_ResourceTypeLowerName[2516:2548]: StorageBucketIAMPolicy,
+	_ResourceTypeName[2548:2575]:      StorageTransferJob,
+	_ResourceTypeLowerName[2548:2575]: StorageTransferJob,
+	_ResourceTypeName[2575:2603]:      SQLDatabaseInstance,
+	_ResourceTypeLowerName[2575:2603]: SQLDatabaseInstance,
 }
 
 var _ResourceTypeNames = []string{
 	_ResourceTypeName[0:23],
 	_ResourceTypeName[23:46],
 	_ResourceTypeName[46:68],
-	_ResourceTypeName[68:95],
-	_ResourceTypeName[95:124],
-	_ResourceTypeName[124:158],
-	_ResourceTypeName[158:187],
-	_ResourceTypeName[187:217],
-	_ResourceTypeName[217:247],
-	_ResourceTypeName[247:279],
-	_ResourceTypeName[279:312],
-	_ResourceTypeName[312:334],
-	_ResourceTypeName[334:371],
-	_ResourceTypeName[371:401],
-	_ResourceTypeName[401:420],
-	_ResourceTypeName[420:443],
-	_ResourceTypeName[443:464],
-	_ResourceTypeName[464:494],
-	_ResourceTypeName[494:515],
-	_ResourceTypeName[515:547],
-	_ResourceTypeName[547:575],
+	_ResourceTypeName[68:98],
+	_ResourceTypeName[98:125],
+	_ResourceTypeName[125:159],
+	_ResourceTypeName[159:191],
+	_ResourceTypeName[191:224],
+	_ResourceTypeName[224:253],
+	_ResourceTypeName[253:293],
+	_ResourceTypeName[293:327],
+	_ResourceTypeName[327:364],
+	_ResourceTypeName[364:408],
+	_ResourceTypeName[408:442],
+	_ResourceTypeName[442:483],
+	_ResourceTypeName[483:512],
+	_ResourceTypeName[512:542],
+	_ResourceTypeName[542:572],
+	_ResourceTypeName[572:604],
+	_ResourceTypeName[604:637],
+	_ResourceTypeName[637:659],
+	_ResourceTypeName[659:696],
+	_ResourceTypeName[696:735],
+	_ResourceTypeName[735:775],
+	_ResourceTypeName[775:804],
+	_ResourceTypeName[804:841],
+	_ResourceTypeName[841:871],
+	_ResourceTypeName[871:890],
+	_ResourceTypeName[890:920],
+	_ResourceTypeName[920:966],
+	_ResourceTypeName[966:994],
+	_ResourceTypeName[994:1022],
+	_ResourceTypeName[1022:1047],
+	_ResourceTypeName[1047:1091],
+	_ResourceTypeName[1091:1135],
+	_ResourceTypeName[1135:1173],
+	_ResourceTypeName[1173:1208],
+	_ResourceTypeName[1208:1234],
+	_ResourceTypeName[1234:1259],
+	_ResourceTypeName[1259:1290],
+	_ResourceTypeName[1290:1321],
+	_ResourceTypeName[1321:1351],
+	_ResourceTypeName[1351:1383],
+	_ResourceTypeName[1383:1414],
+	_ResourceTypeName[1414:1457],
+	_ResourceTypeName[1457:1483],
+	_ResourceTypeName[1483:1507],
+	_ResourceTypeName[1507:1532],
+	_ResourceTypeName[1532:1555],
+	_ResourceTypeName[1555:1582],
+	_ResourceTypeName[1582:1615],
+	_ResourceTypeName[1615:1640],
+	_ResourceTypeName[1640:1670],
+	_ResourceTypeName[1670:1697],
+	_ResourceTypeName[1697:1718],
+	_ResourceTypeName[1718:1748],
+	_ResourceTypeName[1748:1786],
+	_ResourceTypeName[1786:1809],
+	_ResourceTypeName[1809:1825],
+	_ResourceTypeName[1825:1850],
+	_ResourceTypeName[1850:1891],
+	_ResourceTypeName[1891:1918],
+	_ResourceTypeName[1918:1956],
+	_ResourceTypeName[1956:1985],
+	_ResourceTypeName[1985:2023],
+	_ResourceTypeName[2023:2062],
+	_ResourceTypeName[2062:2086],
+	_ResourceTypeName[2086:2107],
+	_ResourceTypeName[2107:2130],
+	_ResourceTypeName[2130:2151],
+	_ResourceTypeName[2151:2181],
+	_ResourceTypeName[2181:2216],
+	_ResourceTypeName[2216:2240],
+	_ResourceTypeName[2240:2273],
+	_ResourceTypeName[2273:2292],
+	_ResourceTypeName[2292:2313],
+	_ResourceTypeName[2313:2336],
+	_ResourceTypeName[2336:2378],
+	_ResourceTypeName[2378:2425],
+	_ResourceTypeName[2425:2459],
+	_ResourceTypeName[2459:2495],
+	_ResourceTypeName[2495:2516],
+	_ResourceTypeName[2516:2548],
+	_ResourceTypeName[2548:2575],
+	_ResourceTypeName[2575:2603],
 }
 
 // ResourceTypeString retrieves an enum value from the enum constants string name.
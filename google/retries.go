@@ -0,0 +1,95 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// retryStatsKey is the context key of the retryStats
+type retryStatsKey struct{}
+
+// retryStatsValue is the value stored on the context
+// with the resource type the retries are recorded as
+type retryStatsValue struct {
+	stats        *retryStats
+	resourceType string
+}
+
+// retryStats records the retries of the pages of each
+// resource type and the time waited before them.
+// It's safe to use concurrently
+type retryStats struct {
+	mu    sync.Mutex
+	types map[string]*retryStat
+}
+
+type retryStat struct {
+	retries int
+	wait    time.Duration
+}
+
+func newRetryStats() *retryStats {
+	return &retryStats{
+		types: make(map[string]*retryStat),
+	}
+}
+
+// add records a retry of the resourceType after waiting wait
+func (rs *retryStats) add(resourceType string, wait time.Duration) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	s, ok := rs.types[resourceType]
+	if !ok {
+		s = &retryStat{}
+		rs.types[resourceType] = s
+	}
+	s.retries++
+	s.wait += wait
+}
+
+// summary returns the retries and wait of each resource
+// type sorted by type, if there were none it's empty
+func (rs *retryStats) summary() string {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	if len(rs.types) == 0 {
+		return ""
+	}
+
+	types := make([]string, 0, len(rs.types))
+	var wait time.Duration
+	for t, s := range rs.types {
+		types = append(types, t)
+		wait += s.wait
+	}
+	sort.Strings(types)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Retries of the Google APIs requests (%s waiting in total):\n", wait)
+	for _, t := range types {
+		fmt.Fprintf(&b, "  - %s: %d retries, %s waiting\n", t, rs.types[t].retries, rs.types[t].wait)
+	}
+	return b.String()
+}
+
+// withRetryStats returns a copy of the ctx on which the
+// retries are recorded on rs as retries of the resourceType
+func withRetryStats(ctx context.Context, rs *retryStats, resourceType string) context.Context {
+	return context.WithValue(ctx, retryStatsKey{}, retryStatsValue{stats: rs, resourceType: resourceType})
+}
+
+// recordRetry records a retry after waiting wait on
+// the retryStats of the ctx, if it has one
+func recordRetry(ctx context.Context, wait time.Duration) {
+	v, ok := ctx.Value(retryStatsKey{}).(retryStatsValue)
+	if !ok || v.stats == nil {
+		return
+	}
+	v.stats.add(v.resourceType, wait)
+}
@@ -0,0 +1,61 @@
+package google
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// listTimings records how long the listing of each
+// resource type took. It's safe to use concurrently
+type listTimings struct {
+	mu    sync.Mutex
+	types map[string]time.Duration
+}
+
+func newListTimings() *listTimings {
+	return &listTimings{
+		types: make(map[string]time.Duration),
+	}
+}
+
+// add records that listing the resourceType took d
+func (lt *listTimings) add(resourceType string, d time.Duration) {
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+
+	lt.types[resourceType] += d
+}
+
+// summary returns the time of each resource type sorted
+// from the slowest, if there were none it's empty
+func (lt *listTimings) summary() string {
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+
+	if len(lt.types) == 0 {
+		return ""
+	}
+
+	types := make([]string, 0, len(lt.types))
+	var total time.Duration
+	for t, d := range lt.types {
+		types = append(types, t)
+		total += d
+	}
+	sort.Slice(types, func(i, j int) bool {
+		if lt.types[types[i]] == lt.types[types[j]] {
+			return types[i] < types[j]
+		}
+		return lt.types[types[i]] > lt.types[types[j]]
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Time listing the resources of each type (%s in total):\n", total)
+	for _, t := range types {
+		fmt.Fprintf(&b, "  - %s: %s\n", t, lt.types[t])
+	}
+	return b.String()
+}
@@ -0,0 +1,20 @@
+package google
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListTimings(t *testing.T) {
+	lt := newListTimings()
+	assert.Equal(t, "", lt.summary())
+
+	lt.add("google_compute_disk", 2*time.Second)
+	lt.add("google_storage_bucket", time.Second)
+	lt.add("google_compute_instance", 5*time.Second)
+	lt.add("google_compute_network", time.Second)
+
+	assert.Equal(t, "Time listing the resources of each type (9s in total):\n  - google_compute_instance: 5s\n  - google_compute_disk: 2s\n  - google_compute_network: 1s\n  - google_storage_bucket: 1s\n", lt.summary())
+}
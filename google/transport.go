@@ -0,0 +1,210 @@
+package google
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
+
+	"github.com/cycloidio/terracognita/log"
+)
+
+// defaultUserAgent is the User-Agent of the requests
+// if none is set on the Options.UserAgent
+const defaultUserAgent = "terracognita"
+
+// redacted is the value logged instead of the sensitive ones
+const redacted = "REDACTED"
+
+// sensitiveHeaders are the headers which values
+// are redacted when logging the requests
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "X-Goog-Api-Key"}
+
+// proxyDefaultPorts are the ports used to validate
+// the Options.Proxy when it has none
+var proxyDefaultPorts = map[string]string{
+	"http":   "80",
+	"https":  "443",
+	"socks5": "1080",
+}
+
+// proxyDialTimeout is the max time to connect to the
+// Options.Proxy when checking that it's reachable
+var proxyDialTimeout = 10 * time.Second
+
+// httpClient returns the HTTP client used by all the
+// services, the Options.HTTPClient if it's set or else
+// the one authenticated with the credentials. Without
+// credentials but with emulators it's not authenticated
+func httpClient(ctx context.Context, credentials string, opts Options) (*http.Client, error) {
+	if opts.HTTPClient != nil {
+		return opts.HTTPClient, nil
+	}
+	if onlyEmulators(credentials, opts) {
+		return emulatorClient(opts), nil
+	}
+	base, err := baseTransport(opts)
+	if err != nil {
+		return nil, err
+	}
+	if base != http.DefaultTransport {
+		// The tokens of the credentials are
+		// also requested through the base
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Transport: base})
+	}
+	co, err := credentialsOption(ctx, credentials, opts)
+	if err != nil {
+		return nil, err
+	}
+	return authenticatedClient(ctx, co, base, opts)
+}
+
+// authenticatedClient returns the HTTP client authenticated
+// with the co on top of the base transport, with the
+// Options.UserAgent and logging the requests if Options.LogRequests is set
+func authenticatedClient(ctx context.Context, co option.ClientOption, base http.RoundTripper, opts Options) (*http.Client, error) {
+	ua := opts.UserAgent
+	if ua == "" {
+		ua = defaultUserAgent
+	}
+
+	t, err := htransport.NewTransport(ctx, base, co, option.WithScopes(cloudPlatformScope), option.WithUserAgent(ua))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create the HTTP transport")
+	}
+
+	if opts.LogRequests {
+		t = &loggingTransport{next: t}
+	}
+
+	return &http.Client{Transport: t}, nil
+}
+
+// baseTransport returns the http.RoundTripper used to connect
+// to the Google APIs, which goes through the Options.Proxy and
+// trusts the certificates of the Options.CAFile if they are set.
+// The proxy has to be reachable and the CA file valid
+func baseTransport(opts Options) (http.RoundTripper, error) {
+	if opts.Proxy == "" && opts.CAFile == "" {
+		return http.DefaultTransport, nil
+	}
+
+	t := http.DefaultTransport.(*http.Transport).Clone()
+
+	if opts.Proxy != "" {
+		u, err := proxyURL(opts.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		t.Proxy = http.ProxyURL(u)
+	}
+
+	if opts.CAFile != "" {
+		pool, err := caCertPool(opts.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		t.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+
+	return t, nil
+}
+
+// proxyURL parses the proxy and checks that it's reachable
+func proxyURL(proxy string) (*url.URL, error) {
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid proxy %q", proxy)
+	}
+	port, ok := proxyDefaultPorts[u.Scheme]
+	if !ok || u.Hostname() == "" {
+		return nil, errors.Errorf("invalid proxy %q, the expected format is 'SCHEME://HOST[:PORT]' with an http, https or socks5 scheme", proxy)
+	}
+	if u.Port() != "" {
+		port = u.Port()
+	}
+
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(u.Hostname(), port), proxyDialTimeout)
+	if err != nil {
+		return nil, errors.Wrapf(err, "the proxy %q is not reachable", u.Redacted())
+	}
+	conn.Close()
+
+	return u, nil
+}
+
+// caCertPool returns the system certificates
+// with the ones of the PEM file caFile
+func caCertPool(caFile string) (*x509.CertPool, error) {
+	b, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read the CA file %q", caFile)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, errors.Errorf("invalid CA file %q, it has no PEM certificates", caFile)
+	}
+
+	return pool, nil
+}
+
+// loggingTransport is a http.RoundTripper that logs
+// each request with its response status and latency
+type loggingTransport struct {
+	next http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	logger := kitlog.With(log.FromContext(req.Context()), "func", "google.RoundTrip", "method", req.Method, "url", redactURL(req), "headers", redactHeaders(req.Header))
+
+	start := time.Now()
+	res, err := t.next.RoundTrip(req)
+	latency := time.Since(start)
+
+	if err != nil {
+		logger.Log("msg", "request failed", "latency", latency, "error", err)
+		return res, err
+	}
+
+	logger.Log("msg", "request done", "status", res.StatusCode, "latency", latency)
+
+	return res, nil
+}
+
+// redactURL returns the URL of the req without the API key
+func redactURL(req *http.Request) string {
+	u := *req.URL
+	q := u.Query()
+	if q.Get("key") != "" {
+		q.Set("key", redacted)
+		u.RawQuery = q.Encode()
+	}
+	return u.String()
+}
+
+// redactHeaders returns a copy of the h with
+// the sensitiveHeaders redacted
+func redactHeaders(h http.Header) http.Header {
+	rh := h.Clone()
+	for _, k := range sensitiveHeaders {
+		if rh.Get(k) != "" {
+			rh.Set(k, redacted)
+		}
+	}
+	return rh
+}
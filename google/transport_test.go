@@ -0,0 +1,118 @@
+package google
+
+import (
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactURL(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "https://compute.googleapis.com/compute/v1/projects/p/global/networks?alt=json&key=secret", nil)
+
+	u := redactURL(req)
+	assert.NotContains(t, u, "secret")
+	assert.Contains(t, u, "key="+redacted)
+	assert.Contains(t, u, "alt=json")
+}
+
+func TestRedactHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("Authorization", "Bearer secret")
+	h.Set("User-Agent", "terracognita")
+
+	rh := redactHeaders(h)
+	assert.Equal(t, redacted, rh.Get("Authorization"))
+	assert.Equal(t, "terracognita", rh.Get("User-Agent"))
+	assert.Equal(t, "Bearer secret", h.Get("Authorization"), "the original headers are not changed")
+}
+
+func TestLoggingTransport(t *testing.T) {
+	var ua string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ua = r.Header.Get("User-Agent")
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	defer ts.Close()
+
+	c := &http.Client{Transport: &loggingTransport{next: http.DefaultTransport}}
+	req, err := http.NewRequest(http.MethodGet, ts.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set("User-Agent", defaultUserAgent)
+
+	res, err := c.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusTeapot, res.StatusCode)
+	assert.Equal(t, defaultUserAgent, ua)
+}
+
+func TestBaseTransport(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		bt, err := baseTransport(Options{})
+		require.NoError(t, err)
+		assert.Equal(t, http.DefaultTransport, bt)
+	})
+	t.Run("Proxy", func(t *testing.T) {
+		var host string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			host = r.URL.Host
+		}))
+		defer proxy.Close()
+
+		bt, err := baseTransport(Options{Proxy: proxy.URL})
+		require.NoError(t, err)
+
+		res, err := (&http.Client{Transport: bt}).Get("http://compute.googleapis.com/compute/v1/projects/p/global/networks")
+		require.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, "compute.googleapis.com", host)
+	})
+	t.Run("ProxyInvalid", func(t *testing.T) {
+		_, err := baseTransport(Options{Proxy: "proxy:3128"})
+		assert.EqualError(t, err, `invalid proxy "proxy:3128", the expected format is 'SCHEME://HOST[:PORT]' with an http, https or socks5 scheme`)
+	})
+	t.Run("ProxyUnreachable", func(t *testing.T) {
+		proxy := httptest.NewServer(http.NotFoundHandler())
+		proxy.Close()
+
+		_, err := baseTransport(Options{Proxy: proxy.URL})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is not reachable")
+	})
+	t.Run("CAFile", func(t *testing.T) {
+		ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer ts.Close()
+
+		caFile := filepath.Join(t.TempDir(), "ca.pem")
+		b := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+		require.NoError(t, ioutil.WriteFile(caFile, b, 0600))
+
+		_, err := http.Get(ts.URL)
+		require.Error(t, err, "the certificate is not trusted by default")
+
+		bt, err := baseTransport(Options{CAFile: caFile})
+		require.NoError(t, err)
+
+		res, err := (&http.Client{Transport: bt}).Get(ts.URL)
+		require.NoError(t, err)
+		res.Body.Close()
+	})
+	t.Run("CAFileInvalid", func(t *testing.T) {
+		caFile := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, ioutil.WriteFile(caFile, []byte("not a certificate"), 0600))
+
+		_, err := baseTransport(Options{CAFile: caFile})
+		assert.EqualError(t, err, fmt.Sprintf("invalid CA file %q, it has no PEM certificates", caFile))
+
+		_, err = baseTransport(Options{CAFile: filepath.Join(t.TempDir(), "missing.pem")})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,151 @@
+package hcl
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/cycloidio/mxwriter"
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/writer"
+)
+
+const (
+	// jsonCommentKey is the key of the comments
+	// on the Terraform JSON syntax
+	jsonCommentKey = "//"
+
+	// mapAttributePrefix is the prefix set on the keys that
+	// have to be written as attributes and not blocks on HCL
+	mapAttributePrefix = "=tc="
+
+	// moduleCommentPrefix is the prefix of the keys
+	// that are written as comments on the module
+	moduleCommentPrefix = "# "
+)
+
+// syncJSON writes the content of the Config to the internal
+// w on the Terraform JSON syntax (.tf.json), one document
+// for each category
+func (w *Writer) syncJSON(categories []string) error {
+	for _, category := range categories {
+		cfg, ok := w.Config[category]
+		if !ok {
+			continue
+		}
+
+		// The Config is converted to JSON values so
+		// it can be cleaned without changing it
+		src, err := json.Marshal(cfg)
+		if err != nil {
+			return errors.Wrap(err, "unable to marshal JSON config")
+		}
+		var v map[string]interface{}
+		if err := json.Unmarshal(src, &v); err != nil {
+			return errors.Wrap(err, "unable to unmarshal JSON config")
+		}
+
+		// Empty categories are not written, as on HCL
+		if len(v) == 0 {
+			continue
+		}
+
+		if resources, ok := v["resource"].(map[string]interface{}); ok {
+			if len(resources) == 0 {
+				delete(v, "resource")
+			}
+			for _, rs := range resources {
+				for _, r := range rs.(map[string]interface{}) {
+					r := r.(map[string]interface{})
+					// The resource category and location
+					// are just for internal usage
+					delete(r, writer.ResourceCategoryKey)
+					delete(r, writer.ResourceLocationKey)
+					if w.opts.HCLComment != "" {
+						r[jsonCommentKey] = w.opts.HCLComment
+					}
+				}
+			}
+		}
+
+		b, err := marshalJSON(cleanJSON(v))
+		if err != nil {
+			return err
+		}
+		mxwriter.Write(w.writer, category, b)
+	}
+
+	return nil
+}
+
+// cleanJSON removes from the keys of v the markers used
+// to format the HCL, which are not needed on JSON, and
+// the module comments which JSON does not support
+func cleanJSON(v interface{}) interface{} {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(vv))
+		for k, e := range vv {
+			if strings.HasPrefix(k, moduleCommentPrefix) {
+				continue
+			}
+			m[strings.TrimPrefix(k, mapAttributePrefix)] = cleanJSON(e)
+		}
+		return m
+	case []interface{}:
+		for i, e := range vv {
+			vv[i] = cleanJSON(e)
+		}
+		return vv
+	default:
+		return v
+	}
+}
+
+// MergeJSON merges the Terraform JSON documents, written by
+// the Writer for each category, in only one so they can be
+// written on a single .tf.json file
+func MergeJSON(docs ...[]byte) ([]byte, error) {
+	merged := make(map[string]interface{})
+	for _, d := range docs {
+		var v map[string]interface{}
+		if err := json.Unmarshal(d, &v); err != nil {
+			return nil, errors.Wrap(err, "unable to unmarshal JSON config")
+		}
+		mergeJSON(merged, v)
+	}
+
+	return marshalJSON(merged)
+}
+
+// marshalJSON indents v without escaping the HTML
+// characters, as the '>' of the version constraints
+func marshalJSON(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, errors.Wrap(err, "unable to marshal JSON config")
+	}
+	return buf.Bytes(), nil
+}
+
+// mergeJSON merges the objects of src into dst, the
+// other values of src replace the ones of dst
+func mergeJSON(dst, src map[string]interface{}) {
+	for k, v := range src {
+		sm, ok := v.(map[string]interface{})
+		if !ok {
+			dst[k] = v
+			continue
+		}
+		dm, ok := dst[k].(map[string]interface{})
+		if !ok {
+			dm = make(map[string]interface{})
+			dst[k] = dm
+		}
+		mergeJSON(dm, sm)
+	}
+}
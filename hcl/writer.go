@@ -17,6 +17,7 @@ import (
 	"github.com/cycloidio/terracognita/provider"
 	"github.com/cycloidio/terracognita/util"
 	"github.com/cycloidio/terracognita/writer"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/pkg/errors"
 	"github.com/zclconf/go-cty/cty"
@@ -52,6 +53,14 @@ func NewWriter(w io.Writer, pv provider.Provider, opts *writer.Options) *Writer
 		provider: pv,
 	}
 
+	rp := map[string]interface{}{
+		"source": pv.Source(),
+	}
+	if v, ok := pv.(provider.Versioner); ok {
+		if vc := v.VersionConstraint(); vc != "" {
+			rp["version"] = vc
+		}
+	}
 	tfcfg := map[string]interface{}{
 		"required_version": ">= 1.0",
 		"required_providers": map[string]interface{}{
@@ -60,9 +69,7 @@ func NewWriter(w io.Writer, pv provider.Provider, opts *writer.Options) *Writer
 			// on the formater we have we replace all the '= {` for
 			// just '{' so this would be included too and it would
 			// be invalid configuration
-			fmt.Sprintf("=tc=%s", pv.String()): map[string]interface{}{
-				"source": pv.Source(),
-			},
+			fmt.Sprintf("=tc=%s", pv.String()): rp,
 		},
 	}
 	var cat string
@@ -127,6 +134,26 @@ func (w *Writer) Write(key string, value interface{}) error {
 		category = ic.(string)
 	}
 
+	loc, hasLoc := m[writer.ResourceLocationKey].(string)
+
+	if g, ok := w.opts.HCLFileGroups[keys[0]]; ok {
+		category = g
+	} else if w.opts.HCLFileName != "" {
+		if !hasLoc {
+			loc = category
+		}
+		category = strings.NewReplacer(
+			"{provider}", w.provider.String(),
+			"{type}", strings.TrimPrefix(keys[0], fmt.Sprintf("%s_", w.provider.String())),
+			"{category}", category,
+			"{location}", loc,
+		).Replace(w.opts.HCLFileName)
+	} else if w.opts.HCLFilePerLocation && hasLoc {
+		category = loc
+	} else if w.opts.HCLFilePerType {
+		category = strings.TrimPrefix(keys[0], fmt.Sprintf("%s_", w.provider.String()))
+	}
+
 	if _, ok := w.Config[category]; !ok {
 		w.Config[category] = make(map[string]interface{})
 		w.Config[category]["resource"] = make(map[string]map[string]interface{})
@@ -182,6 +209,10 @@ func (w *Writer) Sync() error {
 		w.setVariables()
 	}
 
+	if w.opts.HCLJSON {
+		return w.syncJSON(categories)
+	}
+
 	for _, category := range categories {
 		f := hclwrite.NewEmptyFile()
 		body := f.Body()
@@ -280,9 +311,9 @@ func (w *Writer) Sync() error {
 					attrMap := resource.AsValueMap()
 					for _, attr := range attrKeys {
 						// We do not want to print on the HCL the
-						// resource category as it's just for
-						// internal usage
-						if attr == writer.ResourceCategoryKey {
+						// resource category and location as they
+						// are just for internal usage
+						if attr == writer.ResourceCategoryKey || attr == writer.ResourceLocationKey {
 							continue
 						}
 						value := attrMap[attr]
@@ -296,6 +327,11 @@ func (w *Writer) Sync() error {
 							bbody.SetAttributeValue(attr, value)
 						}
 					}
+					if w.opts.HCLComment != "" {
+						body.AppendUnstructuredTokens(hclwrite.Tokens{
+							{Type: hclsyntax.TokenComment, Bytes: []byte(fmt.Sprintf("# %s\n", w.opts.HCLComment))},
+						})
+					}
 					body.AppendBlock(block)
 					body.AppendNewline()
 				}
@@ -606,12 +642,18 @@ func extractResourceTypeAndName(value string) (string, string) {
 
 }
 
-// setProviderConfig will set the required fields to the provider configuration
-// under the given category
+// setProviderConfig will set the required fields, and the optional ones of the
+// provider.Configurer, to the provider configuration under the given category
 func (w *Writer) setProviderConfig(cat string) {
 	pcfg := w.provider.Configuration()
+	optional := make(map[string]struct{})
+	if c, ok := w.provider.(provider.Configurer); ok {
+		for _, k := range c.OptionalConfiguration() {
+			optional[k] = struct{}{}
+		}
+	}
 	for k, v := range w.provider.TFProvider().Schema {
-		if v.Required {
+		if _, ok := optional[k]; v.Required || ok {
 			if _, ok := w.Config[cat]["variable"]; !ok {
 				w.Config[cat]["variable"] = make(map[string]interface{})
 			}
@@ -11,6 +11,7 @@ import (
 	"github.com/cycloidio/terracognita/mock"
 	"github.com/cycloidio/terracognita/writer"
 	"github.com/golang/mock/gomock"
+	hcljson "github.com/hashicorp/hcl/v2/json"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -105,8 +106,93 @@ func TestNewHCLWriter(t *testing.T) {
 			},
 		}, hw.Config)
 	})
+	t.Run("SuccessWithVersionConstraint", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			p    = mock.NewProvider(ctrl)
+		)
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().Source().Return("hashicorp/aws")
+
+		hw := hcl.NewWriter(nil, versionedProvider{Provider: p, constraint: "~> 3.0"}, &writer.Options{})
+		assert.Equal(t, map[string]map[string]interface{}{
+			"hcl": map[string]interface{}{
+				"resource": map[string]map[string]interface{}{},
+				"terraform": map[string]interface{}{
+					"required_providers": map[string]interface{}{
+						"=tc=aws": map[string]interface{}{
+							"source":  "hashicorp/aws",
+							"version": "~> 3.0",
+						},
+					},
+					"required_version": ">= 1.0",
+				},
+			},
+		}, hw.Config)
+	})
+	t.Run("SuccessWithOptionalConfiguration", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			p    = mock.NewProvider(ctrl)
+			cfg  = map[string]interface{}{
+				"region":  "eu-west-1",
+				"profile": "dev",
+			}
+		)
+		p.EXPECT().String().Return("aws").Times(7)
+		p.EXPECT().Source().Return("hashicorp/aws").Times(2)
+		p.EXPECT().TFProvider().Return(aws.Provider()).Times(2)
+		p.EXPECT().Configuration().Return(cfg).Times(2)
+
+		// Only the required keys are written by default
+		hw := hcl.NewWriter(nil, p, &writer.Options{HCLProviderBlock: true})
+		assert.Equal(t, map[string]interface{}{
+			"aws": map[string]interface{}{
+				"region": "${var.region}",
+			},
+		}, hw.Config["hcl"]["provider"])
+		assert.Equal(t, map[string]interface{}{
+			"region": map[string]interface{}{
+				"default": "eu-west-1",
+			},
+		}, hw.Config["hcl"]["variable"])
+
+		hw = hcl.NewWriter(nil, configuredProvider{Provider: p, optional: []string{"profile"}}, &writer.Options{HCLProviderBlock: true})
+		assert.Equal(t, map[string]interface{}{
+			"aws": map[string]interface{}{
+				"region":  "${var.region}",
+				"profile": "${var.profile}",
+			},
+		}, hw.Config["hcl"]["provider"])
+		assert.Equal(t, map[string]interface{}{
+			"region": map[string]interface{}{
+				"default": "eu-west-1",
+			},
+			"profile": map[string]interface{}{
+				"default": "dev",
+			},
+		}, hw.Config["hcl"]["variable"])
+	})
 }
 
+// versionedProvider is a Provider
+// that implements the provider.Versioner
+type versionedProvider struct {
+	*mock.Provider
+	constraint string
+}
+
+func (p versionedProvider) VersionConstraint() string { return p.constraint }
+
+// configuredProvider is a Provider
+// that implements the provider.Configurer
+type configuredProvider struct {
+	*mock.Provider
+	optional []string
+}
+
+func (p configuredProvider) OptionalConfiguration() []string { return p.optional }
+
 func TestHCLWriter_Write(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		var (
@@ -239,6 +325,128 @@ func TestHCLWriter_Write(t *testing.T) {
 		err = hw.Write("type.name", map[string]interface{}{})
 		assert.Equal(t, errcode.ErrWriterAlreadyExistsKey, errors.Cause(err))
 	})
+	t.Run("SuccessWithFilePerType", func(t *testing.T) {
+		var (
+			mw    = mxwriter.NewMux()
+			value = map[string]interface{}{
+				"key":         "value",
+				"tc_category": "some-category",
+			}
+			ctrl = gomock.NewController(t)
+			p    = mock.NewProvider(ctrl)
+		)
+		defer ctrl.Finish()
+
+		p.EXPECT().String().Return("aws").Times(2)
+		p.EXPECT().Source().Return("hashicorp/aws")
+
+		hw := hcl.NewWriter(mw, p, &writer.Options{
+			HCLFilePerType: true,
+			HCLFileGroups: map[string]string{
+				"aws_iam_user": "iam",
+			},
+		})
+
+		err := hw.Write("aws_instance.name", value)
+		require.NoError(t, err)
+
+		err = hw.Write("aws_iam_user.name", value)
+		require.NoError(t, err)
+
+		assert.Contains(t, hw.Config, "instance")
+		assert.Contains(t, hw.Config["instance"]["resource"], "aws_instance")
+		assert.Contains(t, hw.Config, "iam")
+		assert.Contains(t, hw.Config["iam"]["resource"], "aws_iam_user")
+		assert.NotContains(t, hw.Config, "some-category")
+	})
+	t.Run("SuccessWithFilePerLocation", func(t *testing.T) {
+		var (
+			mw    = mxwriter.NewMux()
+			value = map[string]interface{}{
+				"key":         "value",
+				"tc_category": "some-category",
+				"tc_location": "europe-west1-b",
+			}
+			noLocValue = map[string]interface{}{
+				"key":         "value",
+				"tc_category": "some-category",
+			}
+			ctrl = gomock.NewController(t)
+			p    = mock.NewProvider(ctrl)
+		)
+		defer ctrl.Finish()
+
+		p.EXPECT().String().Return("google").AnyTimes()
+		p.EXPECT().Source().Return("hashicorp/google")
+
+		hw := hcl.NewWriter(mw, p, &writer.Options{
+			HCLFilePerLocation: true,
+			HCLFileGroups: map[string]string{
+				"google_compute_disk": "disks",
+			},
+		})
+
+		err := hw.Write("google_compute_instance.name", value)
+		require.NoError(t, err)
+
+		err = hw.Write("google_compute_disk.name", value)
+		require.NoError(t, err)
+
+		err = hw.Write("google_compute_network.name", noLocValue)
+		require.NoError(t, err)
+
+		assert.Contains(t, hw.Config, "europe-west1-b")
+		assert.Contains(t, hw.Config["europe-west1-b"]["resource"], "google_compute_instance")
+		assert.Contains(t, hw.Config, "disks")
+		assert.Contains(t, hw.Config["disks"]["resource"], "google_compute_disk")
+		assert.Contains(t, hw.Config, "some-category")
+		assert.Contains(t, hw.Config["some-category"]["resource"], "google_compute_network")
+	})
+	t.Run("SuccessWithFileName", func(t *testing.T) {
+		var (
+			mw    = mxwriter.NewMux()
+			value = map[string]interface{}{
+				"key":         "value",
+				"tc_category": "some-category",
+				"tc_location": "europe-west1-b",
+			}
+			noLocValue = map[string]interface{}{
+				"key":         "value",
+				"tc_category": "some-category",
+			}
+			ctrl = gomock.NewController(t)
+			p    = mock.NewProvider(ctrl)
+		)
+		defer ctrl.Finish()
+
+		p.EXPECT().String().Return("google").AnyTimes()
+		p.EXPECT().Source().Return("hashicorp/google")
+
+		hw := hcl.NewWriter(mw, p, &writer.Options{
+			HCLFileName:        "my-project-{type}-{location}",
+			HCLFilePerLocation: true,
+			HCLFileGroups: map[string]string{
+				"google_compute_disk": "disks",
+			},
+		})
+
+		err := hw.Write("google_compute_instance.name", value)
+		require.NoError(t, err)
+
+		err = hw.Write("google_compute_disk.name", value)
+		require.NoError(t, err)
+
+		err = hw.Write("google_compute_network.name", noLocValue)
+		require.NoError(t, err)
+
+		assert.Contains(t, hw.Config, "my-project-compute_instance-europe-west1-b")
+		assert.Contains(t, hw.Config["my-project-compute_instance-europe-west1-b"]["resource"], "google_compute_instance")
+		assert.Contains(t, hw.Config, "disks")
+		assert.Contains(t, hw.Config["disks"]["resource"], "google_compute_disk")
+		assert.Contains(t, hw.Config, "my-project-compute_network-some-category")
+		assert.Contains(t, hw.Config["my-project-compute_network-some-category"]["resource"], "google_compute_network")
+		assert.NotContains(t, hw.Config, "europe-west1-b")
+	})
 }
 
 func TestHCLWriter_Sync(t *testing.T) {
@@ -296,6 +504,113 @@ resource "type" "name" {
 
 		assert.Equal(t, strings.Join(strings.Fields(ehcl), " "), strings.Join(strings.Fields(string(b)), " "))
 	})
+	t.Run("SuccessWithHCLComment", func(t *testing.T) {
+		var (
+			ctrl  = gomock.NewController(t)
+			p     = mock.NewProvider(ctrl)
+			mx    = mxwriter.NewMux()
+			value = map[string]interface{}{
+				"key": "value",
+			}
+			ehcl = `
+# Imported by Terracognita from the AWS region eu-west-1
+resource "type" "name" {
+  key = "value"
+}
+
+terraform {
+	required_providers {
+		aws = {
+			source = "hashicorp/aws"
+		}
+	}
+	required_version = ">= 1.0"
+}
+
+`
+		)
+
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().Source().Return("hashicorp/aws")
+
+		hw := hcl.NewWriter(mx, p, &writer.Options{HCLComment: "Imported by Terracognita from the AWS region eu-west-1"})
+
+		err := hw.Write("type.name", value)
+		require.NoError(t, err)
+
+		err = hw.Sync()
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadAll(mx)
+		require.NoError(t, err)
+
+		assert.Equal(t, strings.Join(strings.Fields(ehcl), " "), strings.Join(strings.Fields(string(b)), " "))
+	})
+	t.Run("SuccessWithHCLJSON", func(t *testing.T) {
+		var (
+			ctrl  = gomock.NewController(t)
+			p     = mock.NewProvider(ctrl)
+			mx    = mxwriter.NewMux()
+			value = map[string]interface{}{
+				"key":                      "value",
+				"=tc=tags":                 map[string]interface{}{"env": "prod"},
+				"ingress":                  []interface{}{map[string]interface{}{"port": 80}, map[string]interface{}{"port": 443}},
+				"network":                  "${type.network.id}",
+				writer.ResourceCategoryKey: "hcl",
+				writer.ResourceLocationKey: "eu-west-1a",
+			}
+			ejson = `{
+  "resource": {
+    "type": {
+      "name": {
+        "//": "Imported by Terracognita",
+        "ingress": [
+          {
+            "port": 80
+          },
+          {
+            "port": 443
+          }
+        ],
+        "key": "value",
+        "network": "${type.network.id}",
+        "tags": {
+          "env": "prod"
+        }
+      }
+    }
+  },
+  "terraform": {
+    "required_providers": {
+      "aws": {
+        "source": "hashicorp/aws"
+      }
+    },
+    "required_version": ">= 1.0"
+  }
+}
+`
+		)
+
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().Source().Return("hashicorp/aws")
+
+		hw := hcl.NewWriter(mx, p, &writer.Options{HCLJSON: true, HCLComment: "Imported by Terracognita"})
+
+		err := hw.Write("type.name", value)
+		require.NoError(t, err)
+
+		err = hw.Sync()
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadAll(mx)
+		require.NoError(t, err)
+
+		assert.Equal(t, ejson, string(b))
+
+		_, diags := hcljson.Parse(b, "hcl.tf.json")
+		assert.False(t, diags.HasErrors(), diags.Error())
+	})
 	t.Run("SuccessWithoutProviderBlock", func(t *testing.T) {
 		var (
 			ctrl  = gomock.NewController(t)
@@ -898,3 +1213,21 @@ func TestHCLWriter_Interpolate(t *testing.T) {
 		assert.Contains(t, string(b), "network = \"should-not-be-interpolated\"")
 	})
 }
+
+func TestMergeJSON(t *testing.T) {
+	b, err := hcl.MergeJSON(
+		[]byte(`{"resource": {"type": {"a": {"key": "value"}}}, "terraform": {"required_version": ">= 1.0"}}`),
+		[]byte(`{"resource": {"type": {"b": {"key": "value"}}, "type2": {"c": {"key": "value"}}}}`),
+	)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"resource": {
+			"type": {"a": {"key": "value"}, "b": {"key": "value"}},
+			"type2": {"c": {"key": "value"}}
+		},
+		"terraform": {"required_version": ">= 1.0"}
+	}`, string(b))
+
+	_, err = hcl.MergeJSON([]byte(`{`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,3 @@
+// Package imports has all the abstracted logic
+// related to the Terraform import blocks
+package imports
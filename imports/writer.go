@@ -0,0 +1,102 @@
+package imports
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/log"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/writer"
+)
+
+// Writer is a Writer implementation that is meant
+// to generate the Terraform (1.5+) import blocks
+type Writer struct {
+	// Config has the import ID of each
+	// resource address (ex: google_compute_network.default)
+	Config map[string]string
+	writer io.Writer
+	opts   *writer.Options
+}
+
+// NewWriter returns a Writer initialization
+func NewWriter(w io.Writer, opts *writer.Options) *Writer {
+	return &Writer{
+		Config: make(map[string]string),
+		writer: w,
+		opts:   opts,
+	}
+}
+
+// Write expects a key similar to "aws_instance.your_name" and
+// the value to be a provider.Resource, repeated keys will report an error
+func (w *Writer) Write(key string, value interface{}) error {
+	if key == "" {
+		return errcode.ErrWriterRequiredKey
+	}
+
+	if value == nil {
+		return errcode.ErrWriterRequiredValue
+	}
+
+	if _, ok := w.Config[key]; ok {
+		return errors.Wrapf(errcode.ErrWriterAlreadyExistsKey, "with key %q", key)
+	}
+
+	if len(strings.Split(key, ".")) != 2 {
+		return errors.Wrapf(errcode.ErrWriterInvalidKey, "with key %q", key)
+	}
+
+	r, ok := value.(provider.Resource)
+	if !ok {
+		return errors.Wrapf(errcode.ErrWriterInvalidTypeValue, "expected provider.Resource, found %T", value)
+	}
+
+	log.Get().Log("func", "imports.Write", "msg", "writing to internal config", "key", key, "id", r.ID())
+	w.Config[key] = r.ID()
+
+	return nil
+}
+
+// Has checks if the given key it's already present or not
+func (w *Writer) Has(key string) (bool, error) {
+	_, ok := w.Config[key]
+	return ok, nil
+}
+
+// Sync writes the import blocks of the Config to the
+// internal w sorted by address
+func (w *Writer) Sync() error {
+	keys := make([]string, 0, len(w.Config))
+	for k := range w.Config {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	log.Get().Log("func", "imports.Sync", "msg", "writing import blocks")
+	for i, k := range keys {
+		to := k
+		if w.opts.HasModule() {
+			to = fmt.Sprintf("module.%s.%s", w.opts.Module, k)
+		}
+		if i != 0 {
+			if _, err := fmt.Fprintln(w.writer); err != nil {
+				return errors.Wrap(err, "unable to write the import blocks")
+			}
+		}
+		if _, err := fmt.Fprintf(w.writer, "import {\n  to = %s\n  id = %q\n}\n", to, w.Config[k]); err != nil {
+			return errors.Wrap(err, "unable to write the import blocks")
+		}
+	}
+
+	return nil
+}
+
+// Interpolate does nothing as the import
+// blocks only have the ID of the resources
+func (w *Writer) Interpolate(i map[string]string) {}
@@ -0,0 +1,98 @@
+package imports_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/imports"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/writer"
+)
+
+func TestWrite(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			res  = mock.NewResource(ctrl)
+			iw   = imports.NewWriter(nil, &writer.Options{})
+			key  = "google_compute_network.default"
+		)
+		defer ctrl.Finish()
+
+		res.EXPECT().ID().Return("projects/p/global/networks/default").Times(2)
+
+		err := iw.Write(key, res)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]string{
+			key: "projects/p/global/networks/default",
+		}, iw.Config)
+
+		ok, err := iw.Has(key)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+	t.Run("ErrRequiredKey", func(t *testing.T) {
+		iw := imports.NewWriter(nil, &writer.Options{})
+
+		err := iw.Write("", nil)
+		assert.Equal(t, errcode.ErrWriterRequiredKey, errors.Cause(err))
+	})
+	t.Run("ErrInvalidTypeValue", func(t *testing.T) {
+		iw := imports.NewWriter(nil, &writer.Options{})
+
+		err := iw.Write("google_compute_network.default", "potato")
+		assert.Equal(t, errcode.ErrWriterInvalidTypeValue, errors.Cause(err))
+	})
+}
+
+func TestSync(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var (
+			b  = &bytes.Buffer{}
+			iw = imports.NewWriter(b, &writer.Options{})
+		)
+
+		iw.Config = map[string]string{
+			"google_storage_bucket.assets":   "assets",
+			"google_compute_network.default": "projects/p/global/networks/default",
+		}
+
+		err := iw.Sync()
+		require.NoError(t, err)
+		assert.Equal(t, `import {
+  to = google_compute_network.default
+  id = "projects/p/global/networks/default"
+}
+
+import {
+  to = google_storage_bucket.assets
+  id = "assets"
+}
+`, b.String())
+	})
+	t.Run("SuccessModule", func(t *testing.T) {
+		var (
+			b  = &bytes.Buffer{}
+			iw = imports.NewWriter(b, &writer.Options{Module: "test"})
+		)
+
+		iw.Config = map[string]string{
+			"google_storage_bucket.assets": "assets",
+		}
+
+		err := iw.Sync()
+		require.NoError(t, err)
+		assert.Equal(t, `import {
+  to = module.test.google_storage_bucket.assets
+  id = "assets"
+}
+`, b.String())
+	})
+}
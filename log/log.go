@@ -1,6 +1,7 @@
 package log
 
 import (
+	"context"
 	"io"
 	"io/ioutil"
 	"os"
@@ -40,3 +41,24 @@ func Get() kitlog.Logger {
 	Init(ioutil.Discard, false)
 	return logger
 }
+
+// runIDKey is the context key of the run ID
+type runIDKey struct{}
+
+// WithRunID returns a copy of the ctx with the id, which
+// is added to all the logs of the logger returned by
+// FromContext so the logs of the same import (or run)
+// can be correlated, even the ones from goroutines
+func WithRunID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, runIDKey{}, id)
+}
+
+// FromContext returns the initialized logger with
+// the run ID of the ctx, if it has one
+func FromContext(ctx context.Context) kitlog.Logger {
+	l := Get()
+	if id, ok := ctx.Value(runIDKey{}).(string); ok && id != "" {
+		l = kitlog.With(l, "run_id", id)
+	}
+	return l
+}
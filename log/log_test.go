@@ -0,0 +1,25 @@
+package log_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cycloidio/terracognita/log"
+)
+
+func TestFromContext(t *testing.T) {
+	var b bytes.Buffer
+	log.Init(&b, false)
+
+	ctx := log.WithRunID(context.Background(), "42")
+	log.FromContext(ctx).Log("msg", "with")
+	assert.Contains(t, b.String(), "run_id=42 msg=with")
+
+	b.Reset()
+	log.FromContext(context.Background()).Log("msg", "without")
+	assert.NotContains(t, b.String(), "run_id")
+	assert.Contains(t, b.String(), "msg=without")
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// Graph is the dependency graph of the imported Resources,
+// the keys are the Terraform addresses of the Resources and
+// the values the sorted addresses of the Resources they reference
+type Graph map[string][]string
+
+// NewGraph builds the Graph of the rs, which have to be already
+// Read so they have the InstanceState. A Resource references
+// another one when one of its attributes has the value of one
+// of the attributes reference of the other one, which is the
+// same logic used to interpolate the HCL and TFState
+func NewGraph(rs []Resource) (Graph, error) {
+	var (
+		refs  = make(map[string]string)
+		addrs = make(map[Resource]string, len(rs))
+	)
+
+	for _, r := range rs {
+		state := r.InstanceState()
+		if state == nil {
+			continue
+		}
+		addr := address(r)
+		addrs[r] = addr
+
+		attributes, err := r.AttributesReference()
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to fetch attributes of resource %s", addr)
+		}
+		for _, attribute := range attributes {
+			value, ok := state.Attributes[attribute]
+			if !ok || len(value) == 0 {
+				continue
+			}
+			refs[value] = addr
+		}
+	}
+
+	g := make(Graph, len(addrs))
+	for r, addr := range addrs {
+		deps := make(map[string]struct{})
+		for _, value := range r.InstanceState().Attributes {
+			if dep, ok := refs[value]; ok && dep != addr {
+				deps[dep] = struct{}{}
+			}
+		}
+
+		g[addr] = make([]string, 0, len(deps))
+		for dep := range deps {
+			g[addr] = append(g[addr], dep)
+		}
+		sort.Strings(g[addr])
+	}
+
+	return g, nil
+}
+
+// WriteJSON writes the g as a JSON object to w
+func (g Graph) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(g); err != nil {
+		return errors.Wrap(err, "unable to encode the graph")
+	}
+	return nil
+}
+
+// WriteDOT writes the g on the Graphviz DOT format to w
+func (g Graph) WriteDOT(w io.Writer) error {
+	addrs := make([]string, 0, len(g))
+	for addr := range g {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	if _, err := fmt.Fprintln(w, "digraph {"); err != nil {
+		return errors.Wrap(err, "unable to write the graph")
+	}
+	for _, addr := range addrs {
+		if _, err := fmt.Fprintf(w, "\t%q;\n", addr); err != nil {
+			return errors.Wrap(err, "unable to write the graph")
+		}
+		for _, dep := range g[addr] {
+			if _, err := fmt.Fprintf(w, "\t%q -> %q;\n", addr, dep); err != nil {
+				return errors.Wrap(err, "unable to write the graph")
+			}
+		}
+	}
+	if _, err := fmt.Fprintln(w, "}"); err != nil {
+		return errors.Wrap(err, "unable to write the graph")
+	}
+	return nil
+}
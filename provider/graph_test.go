@@ -0,0 +1,91 @@
+package provider_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+func TestGraph(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+
+		rule   = mock.NewResource(ctrl)
+		proxy  = mock.NewResource(ctrl)
+		urlMap = mock.NewResource(ctrl)
+		prv    = mock.NewProvider(ctrl)
+	)
+	defer ctrl.Finish()
+
+	rule.EXPECT().Name().Return("front").AnyTimes()
+	rule.EXPECT().Provider().Return(prv).AnyTimes()
+	rule.EXPECT().Type().Return("google_compute_global_forwarding_rule").AnyTimes()
+	rule.EXPECT().AttributesReference().Return([]string{"id", "self_link"}, nil)
+	rule.EXPECT().InstanceState().Return(&terraform.InstanceState{
+		Attributes: map[string]string{
+			"id":        "projects/p/global/forwardingRules/front",
+			"self_link": "https://www.googleapis.com/compute/v1/projects/p/global/forwardingRules/front",
+			"target":    "https://www.googleapis.com/compute/v1/projects/p/global/targetHttpProxies/front",
+		},
+	}).AnyTimes()
+
+	proxy.EXPECT().Name().Return("front").AnyTimes()
+	proxy.EXPECT().Provider().Return(prv).AnyTimes()
+	proxy.EXPECT().Type().Return("google_compute_target_http_proxy").AnyTimes()
+	proxy.EXPECT().AttributesReference().Return([]string{"id", "self_link"}, nil)
+	proxy.EXPECT().InstanceState().Return(&terraform.InstanceState{
+		Attributes: map[string]string{
+			"id":        "projects/p/global/targetHttpProxies/front",
+			"self_link": "https://www.googleapis.com/compute/v1/projects/p/global/targetHttpProxies/front",
+			"url_map":   "https://www.googleapis.com/compute/v1/projects/p/global/urlMaps/front",
+		},
+	}).AnyTimes()
+
+	urlMap.EXPECT().Name().Return("front").AnyTimes()
+	urlMap.EXPECT().Provider().Return(prv).AnyTimes()
+	urlMap.EXPECT().Type().Return("google_compute_url_map").AnyTimes()
+	urlMap.EXPECT().AttributesReference().Return([]string{"id", "self_link"}, nil)
+	urlMap.EXPECT().InstanceState().Return(&terraform.InstanceState{
+		Attributes: map[string]string{
+			"id":        "projects/p/global/urlMaps/front",
+			"self_link": "https://www.googleapis.com/compute/v1/projects/p/global/urlMaps/front",
+		},
+	}).AnyTimes()
+
+	g, err := provider.NewGraph([]provider.Resource{rule, proxy, urlMap})
+	require.NoError(t, err)
+	assert.Equal(t, provider.Graph{
+		"google_compute_global_forwarding_rule.front": []string{"google_compute_target_http_proxy.front"},
+		"google_compute_target_http_proxy.front":      []string{"google_compute_url_map.front"},
+		"google_compute_url_map.front":                []string{},
+	}, g)
+
+	t.Run("WriteDOT", func(t *testing.T) {
+		b := &bytes.Buffer{}
+		require.NoError(t, g.WriteDOT(b))
+		assert.Equal(t, `digraph {
+	"google_compute_global_forwarding_rule.front";
+	"google_compute_global_forwarding_rule.front" -> "google_compute_target_http_proxy.front";
+	"google_compute_target_http_proxy.front";
+	"google_compute_target_http_proxy.front" -> "google_compute_url_map.front";
+	"google_compute_url_map.front";
+}
+`, b.String())
+	})
+	t.Run("WriteJSON", func(t *testing.T) {
+		b := &bytes.Buffer{}
+		require.NoError(t, g.WriteJSON(b))
+		assert.JSONEq(t, `{
+			"google_compute_global_forwarding_rule.front": ["google_compute_target_http_proxy.front"],
+			"google_compute_target_http_proxy.front": ["google_compute_url_map.front"],
+			"google_compute_url_map.front": []
+		}`, b.String())
+	})
+}
@@ -16,9 +16,14 @@ import (
 )
 
 // Import imports from the Provider p all the resources filtered by f and writes
-// the result to the hcl or tfstate if those are not nil
+// the result to the hcl or tfstate if those are not nil.
+// If the ctx is done before finishing, the resources already imported are
+// still written and errcode.ErrImportDeadline is returned if it was
+// because of the deadline or errcode.ErrImportInterrupted if it was canceled.
+// The resources that fail to be read are skipped and reported on out
+// at the end of the import
 func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filter.Filter, out io.Writer) error {
-	logger := log.Get()
+	logger := log.FromContext(ctx)
 	logger = kitlog.With(logger, "func", "provider.Import")
 
 	if err := f.Validate(); err != nil {
@@ -71,9 +76,22 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 	// to replace each occurence of the key by the value in the HCL file.
 	interpolation := make(map[string]string)
 
+	// ctxErr is set when the ctx is done so we stop importing
+	// and write what has already been imported
+	var ctxErr error
+
+	// unreadable are the resources that were discovered but
+	// failed to be imported or read, they are reported at the end
+	var unreadable []unreadableResource
+
+types:
 	for _, t := range types {
 		logger := kitlog.With(logger, "resource", t)
 
+		if ctxErr = ctx.Err(); ctxErr != nil {
+			break
+		}
+
 		if f.IsExcluded(t) {
 			logger.Log("msg", "excluded")
 			continue
@@ -90,6 +108,10 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 		} else {
 			resources, err = p.Resources(ctx, t, f)
 			if err != nil {
+				if ctxErr = ctx.Err(); ctxErr != nil {
+					logger.Log("msg", "stopped while fetching the list of resources", "error", err)
+					break types
+				}
 				// we filter the error: if it's an error provider side, we continue
 				// the import but we print the error.
 				if errors.Is(err, errcode.ErrProviderAPI) {
@@ -102,13 +124,24 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 
 		resourceLen := len(resources)
 		for i, re := range resources {
+			if ctxErr = ctx.Err(); ctxErr != nil {
+				break types
+			}
+
 			logger := kitlog.With(logger, "id", re.ID(), "total", resourceLen, "current", i+1)
 			fmt.Fprintf(out, "\rImporting %s [%d/%d]", t, i+1, resourceLen)
 
 			logger.Log("msg", "reading from TF")
 			res, err := re.ImportState()
 			if err != nil {
-				return err
+				// As when reading, the resource is skipped
+				// and reported so the import continues
+				cause := errors.Cause(err)
+				logger.Log("error", cause)
+				if reportUnreadable(cause) {
+					unreadable = append(unreadable, unreadableResource{Type: re.Type(), ID: re.ID(), Err: cause})
+				}
+				continue
 			}
 
 			// If the InstanceState is nil after the ImportState it
@@ -131,6 +164,10 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 
 					logger.Log("error", cause)
 
+					if reportUnreadable(cause) {
+						unreadable = append(unreadable, unreadableResource{Type: r.Type(), ID: r.ID(), Err: cause})
+					}
+
 					continue
 				}
 
@@ -164,7 +201,7 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 						if !ok || len(value) == 0 {
 							continue
 						}
-						interpolation[value] = fmt.Sprintf("${%s.%s.%s}", r.Type(), r.Name(), attribute)
+						interpolation[value] = fmt.Sprintf("${%s.%s.%s}", TypeName(p, r.Type()), r.Name(), attribute)
 					}
 				}
 			}
@@ -203,5 +240,42 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 		logger.Log("msg", "writing the TFState done")
 	}
 
+	if len(unreadable) != 0 {
+		fmt.Fprintf(out, "\r%d resources were discovered but could not be read:\n", len(unreadable))
+		for _, u := range unreadable {
+			fmt.Fprintf(out, "  - %s %s: %s\n", u.Type, u.ID, u.Err)
+		}
+		logger.Log("msg", "resources not read", "total", len(unreadable))
+	}
+
+	if s, ok := p.(Summarizer); ok {
+		if sum := s.Summary(); sum != "" {
+			fmt.Fprintf(out, "\r%s", sum)
+		}
+	}
+
+	if ctxErr != nil {
+		if errors.Is(ctxErr, context.DeadlineExceeded) {
+			return errors.WithStack(errcode.ErrImportDeadline)
+		} else if errors.Is(ctxErr, context.Canceled) {
+			return errors.WithStack(errcode.ErrImportInterrupted)
+		}
+		return errors.WithStack(ctxErr)
+	}
+
 	return nil
 }
+
+// reportUnreadable checks if the resource that failed with the
+// cause has to be reported, the ones skipped on purpose are not
+func reportUnreadable(cause error) bool {
+	return cause != errcode.ErrProviderResourceDoNotMatchTag && cause != errcode.ErrProviderResourceAutogenerated
+}
+
+// unreadableResource is a Resource that
+// failed to be imported or read and the reason of it
+type unreadableResource struct {
+	Type string
+	ID   string
+	Err  error
+}
@@ -1,10 +1,12 @@
 package provider_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/cycloidio/terracognita/errcode"
 	"github.com/cycloidio/terracognita/filter"
@@ -351,8 +353,9 @@ func TestImport(t *testing.T) {
 
 		p.EXPECT().Resources(ctx, "aws_iam_user", f).Return([]provider.Resource{iamUser1, iamUser2}, nil)
 
-		iamUser1.EXPECT().ID().Return("1")
+		iamUser1.EXPECT().ID().Return("1").Times(2)
 		iamUser2.EXPECT().ID().Return("2")
+		iamUser1.EXPECT().Type().Return("aws_iam_user")
 
 		iamUser1.EXPECT().ImportState().Return(nil, nil)
 		iamUser2.EXPECT().ImportState().Return(nil, nil)
@@ -546,4 +549,189 @@ func TestImport(t *testing.T) {
 		err := provider.Import(ctx, p, hw, sw, f, ioutil.Discard)
 		require.NoError(t, err)
 	})
+	t.Run("SuccessWithUnreadable", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			ctx  = context.Background()
+
+			p                 = mock.NewProvider(ctrl)
+			hw                = mock.NewWriter(ctrl)
+			sw                = mock.NewWriter(ctrl)
+			instanceResource1 = mock.NewResource(ctrl)
+			instanceResource2 = mock.NewResource(ctrl)
+			i                 = make(map[string]string)
+			out               = &bytes.Buffer{}
+
+			f = &filter.Filter{}
+		)
+
+		defer ctrl.Finish()
+
+		p.EXPECT().ResourceTypes().Return([]string{"aws_instance"})
+		p.EXPECT().Resources(ctx, "aws_instance", f).Return([]provider.Resource{instanceResource1, instanceResource2}, nil)
+
+		instanceResource1.EXPECT().ID().Return("1")
+		instanceResource2.EXPECT().ID().Return("2").Times(2)
+		instanceResource2.EXPECT().Type().Return("aws_instance")
+
+		instanceResource1.EXPECT().ImportState().Return(nil, nil)
+		instanceResource2.EXPECT().ImportState().Return(nil, nil)
+
+		instanceResource1.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+		instanceResource2.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+
+		// The second one fails to be read so it's
+		// skipped and reported at the end
+		instanceResource1.EXPECT().Read(f).Return(nil)
+		instanceResource2.EXPECT().Read(f).Return(errors.New("not found"))
+
+		instanceResource1.EXPECT().HCL(hw).Return(nil)
+		instanceResource1.EXPECT().State(sw).Return(nil)
+		instanceResource1.EXPECT().InstanceState().Return(nil)
+
+		hw.EXPECT().Sync().Return(nil)
+		hw.EXPECT().Interpolate(i)
+		sw.EXPECT().Sync().Return(nil)
+		sw.EXPECT().Interpolate(i)
+
+		err := provider.Import(ctx, p, hw, sw, f, out)
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "1 resources were discovered but could not be read:\n  - aws_instance 2: not found\n")
+	})
+	t.Run("SuccessWithUnimportable", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			ctx  = context.Background()
+
+			p                 = mock.NewProvider(ctrl)
+			hw                = mock.NewWriter(ctrl)
+			sw                = mock.NewWriter(ctrl)
+			instanceResource1 = mock.NewResource(ctrl)
+			instanceResource2 = mock.NewResource(ctrl)
+			i                 = make(map[string]string)
+			out               = &bytes.Buffer{}
+
+			f = &filter.Filter{}
+		)
+
+		defer ctrl.Finish()
+
+		p.EXPECT().ResourceTypes().Return([]string{"aws_instance"})
+		p.EXPECT().Resources(ctx, "aws_instance", f).Return([]provider.Resource{instanceResource1, instanceResource2}, nil)
+
+		instanceResource1.EXPECT().ID().Return("1").Times(2)
+		instanceResource2.EXPECT().ID().Return("2")
+		instanceResource1.EXPECT().Type().Return("aws_instance")
+
+		// The first one fails to be imported so it's
+		// skipped, reported at the end, and the next
+		// one is still imported
+		instanceResource1.EXPECT().ImportState().Return(nil, errors.New("invalid ID"))
+		instanceResource2.EXPECT().ImportState().Return(nil, nil)
+
+		instanceResource2.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+		instanceResource2.EXPECT().Read(f).Return(nil)
+		instanceResource2.EXPECT().HCL(hw).Return(nil)
+		instanceResource2.EXPECT().State(sw).Return(nil)
+		instanceResource2.EXPECT().InstanceState().Return(nil)
+
+		hw.EXPECT().Sync().Return(nil)
+		hw.EXPECT().Interpolate(i)
+		sw.EXPECT().Sync().Return(nil)
+		sw.EXPECT().Interpolate(i)
+
+		err := provider.Import(ctx, p, hw, sw, f, out)
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "1 resources were discovered but could not be read:\n  - aws_instance 1: invalid ID\n")
+	})
+	t.Run("ErrorWithErrImportDeadline", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+
+			p                 = mock.NewProvider(ctrl)
+			hw                = mock.NewWriter(ctrl)
+			sw                = mock.NewWriter(ctrl)
+			instanceResource1 = mock.NewResource(ctrl)
+			instanceResource2 = mock.NewResource(ctrl)
+			i                 = make(map[string]string)
+
+			f = &filter.Filter{}
+		)
+
+		defer ctrl.Finish()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		p.EXPECT().ResourceTypes().Return([]string{"aws_instance", "aws_iam_user"})
+
+		// The deadline is exceeded while importing the
+		// first resource so the second one is not imported
+		p.EXPECT().Resources(ctx, "aws_instance", f).Return([]provider.Resource{instanceResource1, instanceResource2}, nil)
+
+		instanceResource1.EXPECT().ID().Return("1")
+		instanceResource1.EXPECT().ImportState().Return(nil, nil)
+		instanceResource1.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+		instanceResource1.EXPECT().Read(f).Return(nil)
+		instanceResource1.EXPECT().HCL(hw).Return(nil)
+		instanceResource1.EXPECT().State(sw).DoAndReturn(func(w interface{}) error {
+			<-ctx.Done()
+			return nil
+		})
+		instanceResource1.EXPECT().InstanceState().Return(nil)
+
+		hw.EXPECT().Sync().Return(nil)
+		hw.EXPECT().Interpolate(i)
+		sw.EXPECT().Sync().Return(nil)
+		sw.EXPECT().Interpolate(i)
+
+		err := provider.Import(ctx, p, hw, sw, f, ioutil.Discard)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errcode.ErrImportDeadline))
+	})
+	t.Run("ErrorWithErrImportInterrupted", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+
+			p                 = mock.NewProvider(ctrl)
+			hw                = mock.NewWriter(ctrl)
+			sw                = mock.NewWriter(ctrl)
+			instanceResource1 = mock.NewResource(ctrl)
+			instanceResource2 = mock.NewResource(ctrl)
+			i                 = make(map[string]string)
+
+			f = &filter.Filter{}
+		)
+
+		defer ctrl.Finish()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		p.EXPECT().ResourceTypes().Return([]string{"aws_instance", "aws_iam_user"})
+
+		// The import is interrupted while importing the
+		// first resource so the second one is not imported
+		p.EXPECT().Resources(ctx, "aws_instance", f).Return([]provider.Resource{instanceResource1, instanceResource2}, nil)
+
+		instanceResource1.EXPECT().ID().Return("1")
+		instanceResource1.EXPECT().ImportState().Return(nil, nil)
+		instanceResource1.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+		instanceResource1.EXPECT().Read(f).Return(nil)
+		instanceResource1.EXPECT().HCL(hw).Return(nil)
+		instanceResource1.EXPECT().State(sw).DoAndReturn(func(w interface{}) error {
+			cancel()
+			return nil
+		})
+		instanceResource1.EXPECT().InstanceState().Return(nil)
+
+		hw.EXPECT().Sync().Return(nil)
+		hw.EXPECT().Interpolate(i)
+		sw.EXPECT().Sync().Return(nil)
+		sw.EXPECT().Interpolate(i)
+
+		err := provider.Import(ctx, p, hw, sw, f, ioutil.Discard)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errcode.ErrImportInterrupted))
+	})
 }
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/cycloidio/terracognita/tag"
+	"github.com/pkg/errors"
+)
+
+// ManifestEntry is the representation of a Resource
+// on the Manifest
+type ManifestEntry struct {
+	// Address is the Terraform address of the
+	// Resource (ex: aws_instance.front), with the
+	// type name of the Provider if it's a Renamer
+	Address string `json:"address"`
+
+	// ID is the ID used to import the Resource
+	ID string `json:"id"`
+
+	// Type is the type of the Resource (ex: aws_instance)
+	Type string `json:"type"`
+}
+
+// Manifest returns the ManifestEntry of each one of the rs
+// sorted by Type and ID so the output is stable between runs.
+// If the Resource has not been named yet (by writing it to the HCL
+// or TFState) the name is calculated the same way those writers do it
+func Manifest(rs []Resource) []ManifestEntry {
+	entries := make([]ManifestEntry, 0, len(rs))
+	for _, r := range rs {
+		entries = append(entries, ManifestEntry{
+			Address: address(r),
+			ID:      r.ID(),
+			Type:    r.Type(),
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Type != entries[j].Type {
+			return entries[i].Type < entries[j].Type
+		}
+		return entries[i].ID < entries[j].ID
+	})
+
+	return entries
+}
+
+// address returns the Terraform address of the r, with the type
+// name it's written with, if it has not been named yet the name
+// is calculated from its tags or ID
+func address(r Resource) string {
+	name := r.Name()
+	if name == "" {
+		name = tag.GetNameFromTag(r.Provider().TagKey(), r.Data(), r.ID())
+	}
+	return fmt.Sprintf("%s.%s", TypeName(r.Provider(), r.Type()), name)
+}
+
+// WriteManifest writes the JSON Manifest of the rs to w.
+// It does not depend on the HCL or TFState generation, so
+// it can be used directly with the Resources returned from
+// the Provider
+func WriteManifest(w io.Writer, rs []Resource) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(Manifest(rs)); err != nil {
+		return errors.Wrap(err, "unable to encode the manifest")
+	}
+	return nil
+}
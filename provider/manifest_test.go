@@ -0,0 +1,88 @@
+package provider_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteManifest(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		buff = &bytes.Buffer{}
+
+		instance = mock.NewResource(ctrl)
+		bucket   = mock.NewResource(ctrl)
+		network  = mock.NewResource(ctrl)
+		prv      = mock.NewProvider(ctrl)
+	)
+
+	defer ctrl.Finish()
+
+	instance.EXPECT().Name().Return("front")
+	instance.EXPECT().Provider().Return(prv)
+	instance.EXPECT().ID().Return("project/zone/front").Times(1)
+	instance.EXPECT().Type().Return("google_compute_instance").Times(2)
+
+	bucket.EXPECT().Name().Return("assets")
+	bucket.EXPECT().Provider().Return(prv)
+	bucket.EXPECT().ID().Return("assets").Times(1)
+	bucket.EXPECT().Type().Return("google_storage_bucket").Times(2)
+
+	network.EXPECT().Name().Return("default")
+	network.EXPECT().Provider().Return(prv)
+	network.EXPECT().ID().Return("default").Times(1)
+	network.EXPECT().Type().Return("google_compute_network").Times(2)
+
+	err := provider.WriteManifest(buff, []provider.Resource{bucket, instance, network})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `[
+		{ "address": "google_compute_instance.front", "id": "project/zone/front", "type": "google_compute_instance" },
+		{ "address": "google_compute_network.default", "id": "default", "type": "google_compute_network" },
+		{ "address": "google_storage_bucket.assets", "id": "assets", "type": "google_storage_bucket" }
+	]`, buff.String())
+}
+
+func TestWriteManifestRenamer(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		buff = &bytes.Buffer{}
+
+		instance = mock.NewResource(ctrl)
+		prv      = renamedProvider{Provider: mock.NewProvider(ctrl), names: map[string]string{"google_compute_instance": "google_compute_vm"}}
+	)
+
+	defer ctrl.Finish()
+
+	instance.EXPECT().Name().Return("front")
+	instance.EXPECT().Provider().Return(prv)
+	instance.EXPECT().ID().Return("project/zone/front").Times(1)
+	instance.EXPECT().Type().Return("google_compute_instance").Times(2)
+
+	err := provider.WriteManifest(buff, []provider.Resource{instance})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `[
+		{ "address": "google_compute_vm.front", "id": "project/zone/front", "type": "google_compute_instance" }
+	]`, buff.String())
+}
+
+// renamedProvider is a Provider that
+// implements the provider.Renamer
+type renamedProvider struct {
+	*mock.Provider
+	names map[string]string
+}
+
+func (p renamedProvider) TypeName(t string) string {
+	if n, ok := p.names[t]; ok {
+		return n
+	}
+	return t
+}
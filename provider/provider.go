@@ -53,3 +53,58 @@ type Provider interface {
 	// attributes as defined on the TF Schema
 	Configuration() map[string]interface{}
 }
+
+// Locator can be implemented by the Providers to
+// give the location (ex: zone or region) of the
+// resources, which is used to group them when writing
+type Locator interface {
+	// Location returns the location of the resource
+	// of type t with the id, empty if it has none
+	Location(t, id string) string
+}
+
+// Summarizer can be implemented by the Providers
+// to report information about the Import, like the
+// retries done, which is written at the end of it
+type Summarizer interface {
+	// Summary returns the report, empty
+	// if there is nothing to report
+	Summary() string
+}
+
+// Renamer can be implemented by the Providers to write
+// the resources with a type name different from the one
+// of the TF provider used to read them, as the types
+// renamed on other major versions of the TF provider
+type Renamer interface {
+	// TypeName returns the name with which
+	// the resource type t has to be written
+	TypeName(t string) string
+}
+
+// Versioner can be implemented by the Providers to declare
+// the version of the TF provider the resources are written for
+type Versioner interface {
+	// VersionConstraint returns the version constraint of the
+	// TF provider on the required_providers (ex: ~> 4.0),
+	// empty if it has none
+	VersionConstraint() string
+}
+
+// Configurer can be implemented by the Providers to write, on
+// the provider block, optional keys of their Configuration as
+// by default only the required ones are written
+type Configurer interface {
+	// OptionalConfiguration returns the optional keys
+	// of the Configuration to write on the provider block
+	OptionalConfiguration() []string
+}
+
+// TypeName returns the name with which the resource type t
+// of the p has to be written, which is t if p is not a Renamer
+func TypeName(p Provider, t string) string {
+	if rn, ok := p.(Renamer); ok {
+		return rn.TypeName(t)
+	}
+	return t
+}
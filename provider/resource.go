@@ -163,6 +163,10 @@ func (r *resource) ID() string { return r.id }
 
 func (r *resource) Type() string { return r.resourceType }
 
+// typeName is the name with which the resource is
+// written, which may differ from the Type on Renamers
+func (r *resource) typeName() string { return TypeName(r.provider, r.resourceType) }
+
 func (r *resource) Name() string { return r.configName }
 
 func (r *resource) InstanceState() *terraform.InstanceState { return r.state }
@@ -326,20 +330,20 @@ func (r *resource) State(w writer.Writer) error {
 		// and store it, so net time it'll use that one on any config
 		if r.configName == "" {
 			configName := tag.GetNameFromTag(r.provider.TagKey(), r.data, r.id)
-			if ok, err := w.Has(fmt.Sprintf("%s.%s", r.resourceType, configName)); err != nil {
+			if ok, err := w.Has(fmt.Sprintf("%s.%s", r.typeName(), configName)); err != nil {
 				return err
 			} else if ok {
 				configName = pwgen.Alpha(5)
 			}
 
-			err := w.Write(fmt.Sprintf("%s.%s", r.resourceType, configName), r)
+			err := w.Write(fmt.Sprintf("%s.%s", r.typeName(), configName), r)
 			if err != nil {
 				return err
 			}
 
 			r.configName = configName
 		} else {
-			err := w.Write(fmt.Sprintf("%s.%s", r.resourceType, r.configName), r)
+			err := w.Write(fmt.Sprintf("%s.%s", r.typeName(), r.configName), r)
 			if err != nil {
 				return err
 			}
@@ -367,24 +371,30 @@ func (r *resource) HCL(w writer.Writer) error {
 	// This will convert all Category into snake_case
 	cfg[writer.ResourceCategoryKey] = strings.ToLower(name.Delimit(tfdoc.Category, '_'))
 
+	if l, ok := r.provider.(Locator); ok {
+		if loc := l.Location(r.Type(), r.ID()); loc != "" {
+			cfg[writer.ResourceLocationKey] = loc
+		}
+	}
+
 	// If it does not have any configName we will generate one
 	// and store it, so net time it'll use that one on any config
 	if r.configName == "" {
 		configName := tag.GetNameFromTag(r.provider.TagKey(), r.data, r.id)
-		if ok, err := w.Has(fmt.Sprintf("%s.%s", r.resourceType, configName)); err != nil {
+		if ok, err := w.Has(fmt.Sprintf("%s.%s", r.typeName(), configName)); err != nil {
 			return err
 		} else if ok {
 			configName = pwgen.Alpha(5)
 		}
 
-		err := w.Write(fmt.Sprintf("%s.%s", r.resourceType, configName), cfg)
+		err := w.Write(fmt.Sprintf("%s.%s", r.typeName(), configName), cfg)
 		if err != nil {
 			return err
 		}
 
 		r.configName = configName
 	} else {
-		err := w.Write(fmt.Sprintf("%s.%s", r.resourceType, r.configName), cfg)
+		err := w.Write(fmt.Sprintf("%s.%s", r.typeName(), r.configName), cfg)
 		if err != nil {
 			return err
 		}
@@ -548,21 +558,28 @@ func hasConflict(res map[string]interface{}, keys []string) bool {
 	return false
 }
 
-// normalizeValue removes the \n from the value now
+// normalizeValue removes the \n from the JSON documents (ex: policies)
+// as they are not meaningful on them, the other values keep them as
+// they are part of the value (ex: scripts or certificates)
 func normalizeValue(v interface{}) interface{} {
-	if s, ok := v.(string); ok {
+	if s, ok := v.(string); ok && json.Valid([]byte(s)) {
 		return strings.Replace(s, "\n", "", -1)
 	}
 	return v
 }
 
-var iamInternpolationRe = regexp.MustCompile(`(\$\{[^}]+\})`)
+// interpolationValueRe matches the values that are only an
+// interpolation (ex: ${a.b.c}), which the hcl.Format converts
+// to references after the HCL writer has escaped them
+var interpolationValueRe = regexp.MustCompile(`^\$\{[^$}{]+\.[^$}{]+\}$`)
 
 // normalizeInterpolation fixes the https://github.com/hashicorp/terraform/issues/18937
-// on reading
+// on reading for the values that are only an interpolation, the
+// interpolations inside of the values (ex: on scripts) are
+// already escaped by the HCL writer
 func normalizeInterpolation(v interface{}) interface{} {
-	if s, ok := v.(string); ok {
-		return iamInternpolationRe.ReplaceAllString(s, `$$$1`)
+	if s, ok := v.(string); ok && interpolationValueRe.MatchString(s) {
+		return "$" + s
 	}
 	return v
 }
@@ -0,0 +1,95 @@
+package provider_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/cycloidio/mxwriter"
+	"github.com/golang/mock/gomock"
+	hcl2 "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/terraform-providers/terraform-provider-aws/aws"
+
+	"github.com/cycloidio/terracognita/hcl"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/writer"
+)
+
+func TestResourceHCL(t *testing.T) {
+	t.Run("PolicyDocument", func(t *testing.T) {
+		// The interpolations of the IAM policy variables are
+		// kept as they are, and the new lines of the JSON removed
+		attrs := writeAWSPolicy(t, map[string]string{
+			"name":   "users",
+			"policy": "{\n  \"Resource\": \"arn:aws:s3:::bucket/${aws:username}/*\"\n}",
+		})
+		assert.Equal(t, `{  "Resource": "arn:aws:s3:::bucket/${aws:username}/*"}`, attrs["policy"])
+	})
+	t.Run("WholeInterpolation", func(t *testing.T) {
+		attrs := writeAWSPolicy(t, map[string]string{
+			"name":        "users",
+			"policy":      "${aws:username}",
+			"description": "${aws_s3_bucket.bucket.arn}",
+		})
+		assert.Equal(t, "${aws:username}", attrs["policy"])
+		// The ones that look like a reference are
+		// pre-escaped for the hashicorp/terraform#18937
+		assert.Equal(t, "$${aws_s3_bucket.bucket.arn}", attrs["description"])
+	})
+	t.Run("MultiLine", func(t *testing.T) {
+		attrs := writeAWSPolicy(t, map[string]string{
+			"name":        "users",
+			"policy":      "{}",
+			"description": "Access to the bucket\nof ${aws:username}",
+		})
+		assert.Equal(t, "Access to the bucket\nof ${aws:username}", attrs["description"])
+	})
+}
+
+// writeAWSPolicy writes the HCL of an aws_iam_policy with the
+// values and returns the values of its attributes once parsed
+func writeAWSPolicy(t *testing.T, values map[string]string) map[string]string {
+	var (
+		ctrl = gomock.NewController(t)
+		p    = mock.NewProvider(ctrl)
+	)
+	defer ctrl.Finish()
+
+	p.EXPECT().String().Return("aws").AnyTimes()
+	p.EXPECT().Source().Return("hashicorp/aws").AnyTimes()
+	p.EXPECT().TagKey().Return("tags").AnyTimes()
+	p.EXPECT().TFProvider().Return(aws.Provider()).AnyTimes()
+
+	r := provider.NewResource("users", "aws_iam_policy", p)
+	for k, v := range values {
+		require.NoError(t, r.Data().Set(k, v))
+	}
+
+	mx := mxwriter.NewMux()
+	hw := hcl.NewWriter(mx, p, &writer.Options{})
+	require.NoError(t, r.HCL(hw))
+	require.NoError(t, hw.Sync())
+	b, err := ioutil.ReadAll(mx)
+	require.NoError(t, err)
+
+	f, diags := hclsyntax.ParseConfig(b, "hcl.tf", hcl2.InitialPos)
+	require.False(t, diags.HasErrors(), diags.Error())
+	var body *hclsyntax.Body
+	for _, blk := range f.Body.(*hclsyntax.Body).Blocks {
+		if blk.Type == "resource" {
+			body = blk.Body
+		}
+	}
+	require.NotNil(t, body)
+
+	attrs := make(map[string]string)
+	for k, a := range body.Attributes {
+		v, diags := a.Expr.Value(nil)
+		require.False(t, diags.HasErrors(), diags.Error())
+		attrs[k] = v.AsString()
+	}
+	return attrs
+}
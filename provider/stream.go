@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/filter"
+)
+
+// Stream lists the Resources of the types from the Provider p,
+// filtered by f, and sends them over the returned Resource channel
+// as soon as the listing of each type is done, instead of collecting
+// all of them first. If types is empty all the p.ResourceTypes are used.
+// The errors of each type are sent over the error channel, which is
+// buffered so it does not need to be read while reading the Resources,
+// and the stream continues with the next type.
+// Both channels are closed when all the types have been listed
+// or the ctx is done
+func Stream(ctx context.Context, p Provider, types []string, f *filter.Filter) (<-chan Resource, <-chan error) {
+	if len(types) == 0 {
+		types = p.ResourceTypes()
+	}
+
+	resources := make(chan Resource)
+	errs := make(chan error, len(types)+1)
+
+	go func() {
+		defer close(resources)
+		defer close(errs)
+
+		for _, t := range types {
+			rs, err := p.Resources(ctx, t, f)
+			if err != nil {
+				errs <- errors.Wrapf(err, "unable to list the resources of type %q", t)
+				continue
+			}
+			for _, r := range rs {
+				select {
+				case resources <- r:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+		}
+	}()
+
+	return resources, errs
+}
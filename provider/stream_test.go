@@ -0,0 +1,50 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+func TestStream(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			p    = mock.NewProvider(ctrl)
+			ctx  = context.Background()
+			f    = &filter.Filter{}
+
+			instance = mock.NewResource(ctrl)
+			network  = mock.NewResource(ctrl)
+		)
+		defer ctrl.Finish()
+
+		p.EXPECT().ResourceTypes().Return([]string{"google_compute_instance", "google_storage_bucket", "google_compute_network"})
+		p.EXPECT().Resources(ctx, "google_compute_instance", f).Return([]provider.Resource{instance}, nil)
+		p.EXPECT().Resources(ctx, "google_storage_bucket", f).Return(nil, errors.New("failed"))
+		p.EXPECT().Resources(ctx, "google_compute_network", f).Return([]provider.Resource{network}, nil)
+
+		rch, ech := provider.Stream(ctx, p, nil, f)
+
+		var rs []provider.Resource
+		for r := range rch {
+			rs = append(rs, r)
+		}
+		var errs []error
+		for err := range ech {
+			errs = append(errs, err)
+		}
+
+		assert.Equal(t, []provider.Resource{instance, network}, rs)
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Error(), "google_storage_bucket")
+	})
+}
@@ -0,0 +1,57 @@
+package state
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/pkg/errors"
+)
+
+// ResourceIDs reads the TFState from r and returns the IDs
+// of the managed resources on it indexed by resource type
+func ResourceIDs(r io.Reader) (map[string]map[string]struct{}, error) {
+	file, err := statefile.Read(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the TFState")
+	}
+
+	ids := make(map[string]map[string]struct{})
+	for _, m := range file.State.Modules {
+		for _, rs := range m.Resources {
+			if rs.Addr.Resource.Mode != addrs.ManagedResourceMode {
+				continue
+			}
+			for _, is := range rs.Instances {
+				if is.Current == nil {
+					continue
+				}
+
+				var id string
+				if is.Current.AttrsJSON != nil {
+					var attrs struct {
+						ID string `json:"id"`
+					}
+					if err := json.Unmarshal(is.Current.AttrsJSON, &attrs); err != nil {
+						return nil, errors.Wrapf(err, "unable to decode the attributes of %s", rs.Addr)
+					}
+					id = attrs.ID
+				} else {
+					id = is.Current.AttrsFlat["id"]
+				}
+				if id == "" {
+					continue
+				}
+
+				t := rs.Addr.Resource.Type
+				if _, ok := ids[t]; !ok {
+					ids[t] = make(map[string]struct{})
+				}
+				ids[t][id] = struct{}{}
+			}
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,66 @@
+package state_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/state"
+)
+
+func TestResourceIDs(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		tfstate := `{
+  "version": 4,
+  "terraform_version": "0.13.5",
+  "serial": 1,
+  "lineage": "a6a7ce5a-5ae8-4a5b-a8f8-4b7bfa0c5e2d",
+  "outputs": {},
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "google_compute_network",
+      "name": "default",
+      "provider": "provider[\"registry.terraform.io/hashicorp/google\"]",
+      "instances": [
+        {
+          "schema_version": 0,
+          "attributes": {
+            "id": "projects/my-project/global/networks/default",
+            "name": "default"
+          }
+        }
+      ]
+    },
+    {
+      "mode": "data",
+      "type": "google_compute_network",
+      "name": "data",
+      "provider": "provider[\"registry.terraform.io/hashicorp/google\"]",
+      "instances": [
+        {
+          "schema_version": 0,
+          "attributes": {
+            "id": "projects/my-project/global/networks/data"
+          }
+        }
+      ]
+    }
+  ]
+}`
+
+		ids, err := state.ResourceIDs(strings.NewReader(tfstate))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]map[string]struct{}{
+			"google_compute_network": map[string]struct{}{
+				"projects/my-project/global/networks/default": struct{}{},
+			},
+		}, ids)
+	})
+	t.Run("ErrInvalidState", func(t *testing.T) {
+		_, err := state.ResourceIDs(strings.NewReader("potato"))
+		assert.Error(t, err)
+	})
+}
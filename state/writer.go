@@ -73,12 +73,13 @@ func (w *Writer) Write(key string, value interface{}) error {
 		}
 	}
 
+	prv := r.Provider()
 	absAddr := addrs.AbsResourceInstance{
 		Module: md,
 		Resource: addrs.ResourceInstance{
 			Resource: addrs.Resource{
 				Mode: addrs.ManagedResourceMode,
-				Type: r.Type(),
+				Type: provider.TypeName(prv, r.Type()),
 				Name: strings.Split(key, ".")[1],
 			},
 			Key: nil,
@@ -87,7 +88,7 @@ func (w *Writer) Write(key string, value interface{}) error {
 
 	absProviderConf := addrs.AbsProviderConfig{
 		Module:   nil,
-		Provider: addrs.NewDefaultProvider(r.Provider().String()),
+		Provider: addrs.NewDefaultProvider(prv.String()),
 	}
 
 	zt, err := util.HashicorpToZclonfType(r.ImpliedType())
@@ -167,7 +168,8 @@ func (w *Writer) Interpolate(i map[string]string) {
 					rt, rn := extractResourceTypeAndName(dependency)
 					rsc := fmt.Sprintf("%s.%s", rt, rn)
 					// avoid mutual dependencies
-					if rt == res.Type() || name == rsc || isMutualInterpolation(name, rsc, relations) {
+					// the type of the name may be renamed from the res.Type()
+					if rt == res.Type() || rt == strings.Split(name, ".")[0] || name == rsc || isMutualInterpolation(name, rsc, relations) {
 						continue
 					}
 					// avoid adding the same dependency for a resource
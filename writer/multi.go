@@ -0,0 +1,60 @@
+package writer
+
+// multi is a Writer that writes
+// to multiple Writers at once
+type multi struct {
+	writers []Writer
+}
+
+// NewMulti returns a Writer that duplicates the
+// calls to all the ws, the nil ones are ignored
+func NewMulti(ws ...Writer) Writer {
+	m := &multi{}
+	for _, w := range ws {
+		if w != nil {
+			m.writers = append(m.writers, w)
+		}
+	}
+	return m
+}
+
+// Write writes the key and value to all the writers
+func (m *multi) Write(key string, value interface{}) error {
+	for _, w := range m.writers {
+		if err := w.Write(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Has checks if any of the writers has the key
+func (m *multi) Has(key string) (bool, error) {
+	for _, w := range m.writers {
+		ok, err := w.Has(key)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Sync syncs all the writers
+func (m *multi) Sync() error {
+	for _, w := range m.writers {
+		if err := w.Sync(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Interpolate interpolates all the writers
+func (m *multi) Interpolate(i map[string]string) {
+	for _, w := range m.writers {
+		w.Interpolate(i)
+	}
+}
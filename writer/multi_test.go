@@ -0,0 +1,42 @@
+package writer_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/writer"
+)
+
+func TestMulti(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		w1   = mock.NewWriter(ctrl)
+		w2   = mock.NewWriter(ctrl)
+		mw   = writer.NewMulti(w1, nil, w2)
+		key  = "google_compute_network.default"
+		i    = map[string]string{"a": "b"}
+	)
+	defer ctrl.Finish()
+
+	w1.EXPECT().Write(key, "value").Return(nil)
+	w2.EXPECT().Write(key, "value").Return(nil)
+	require.NoError(t, mw.Write(key, "value"))
+
+	w1.EXPECT().Has(key).Return(false, nil)
+	w2.EXPECT().Has(key).Return(true, nil)
+	ok, err := mw.Has(key)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	w1.EXPECT().Interpolate(i)
+	w2.EXPECT().Interpolate(i)
+	mw.Interpolate(i)
+
+	w1.EXPECT().Sync().Return(nil)
+	w2.EXPECT().Sync().Return(nil)
+	require.NoError(t, mw.Sync())
+}
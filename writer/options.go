@@ -20,6 +20,41 @@ type Options struct {
 	// HCLProviderBlock make the HCL generate or not the
 	// 'provider "" {}' block
 	HCLProviderBlock bool
+
+	// HCLFilePerType writes each resource type on its
+	// own HCL category (file) named after the type
+	// without the provider prefix (ex: compute_instance)
+	HCLFilePerType bool
+
+	// HCLFileGroups maps a resource type to the
+	// category (file) in which it has to be written,
+	// it has priority over the HCLFilePerType
+	HCLFileGroups map[string]string
+
+	// HCLFilePerLocation writes the resources with a
+	// location (zone or region) on the category (file)
+	// named after it, the ones without keep their
+	// category. It has priority over the HCLFilePerType
+	// but not over the HCLFileGroups
+	HCLFilePerLocation bool
+
+	// HCLFileName is the template of the category (file)
+	// name of each resource, which can have the {provider},
+	// {type} (without the provider prefix), {category} and
+	// {location} placeholders. The resources without a
+	// location use their category as {location}. It has
+	// priority over the HCLFilePerType and HCLFilePerLocation
+	// but not over the HCLFileGroups
+	HCLFileName string
+
+	// HCLComment is a comment written on top of each
+	// resource block of the HCL. If empty none is written
+	HCLComment string
+
+	// HCLJSON writes the configuration on the Terraform
+	// JSON syntax (.tf.json) instead of HCL, with one
+	// document for each category
+	HCLJSON bool
 }
 
 // HasModule will check if the Module is empty or not
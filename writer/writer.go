@@ -8,6 +8,11 @@ const (
 	// will be written
 	ResourceCategoryKey = "tc_category"
 
+	// ResourceLocationKey is an internal key used to specify the location
+	// (ex: zone or region) of a resource when writing, it'll be used to
+	// select in which file will be written if the Options.HCLFilePerLocation
+	ResourceLocationKey = "tc_location"
+
 	// ModuleCategoryKey is the category used to identify
 	// the Module
 	ModuleCategoryKey = "tc_module"